@@ -3,25 +3,32 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/joho/godotenv"
+	"github.com/vektah/gqlparser/v2/ast"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	graph "muze/graphql"
+	"muze/internal/auth"
+	"muze/internal/storage"
 	pb "muze/proto"
 )
 
-type contextKey string
-
-const userContextKey contextKey = "user"
-
 func main() {
 	// Load environment variables
 	if err := godotenv.Load(); err != nil {
@@ -41,9 +48,20 @@ func main() {
 	defer conn.Close()
 
 	grpcClient := pb.NewPostServiceClient(conn)
-
-	// Generate a JWT token for testing
-	jwtToken := generateJWT()
+	userClient := pb.NewUserServiceClient(conn)
+	notificationClient := pb.NewNotificationServiceClient(conn)
+
+	// Only development builds hand out a token in the playground
+	jwtToken := "&lt;your-jwt-token&gt;"
+	tokenSection := `<p>Send a JWT issued for your account in the Authorization header for mutations and subscriptions.</p>`
+	if isDevelopment() {
+		jwtToken, err = auth.GenerateToken(devUserID, "Dev User")
+		if err != nil {
+			log.Fatalf("Failed to generate development token: %v", err)
+		}
+		tokenSection = fmt.Sprintf(`<div class="jwt-token">%s</div>
+            <p><strong>Use this token in the Authorization header for mutations:</strong> <code>Bearer %s</code></p>`, jwtToken, jwtToken)
+	}
 
 	// GraphQL playground HTML
 	playgroundHTML := fmt.Sprintf(`
@@ -70,8 +88,7 @@ func main() {
         
         <div class="section">
             <h3>JWT Token for Mutations</h3>
-            <div class="jwt-token">%s</div>
-            <p><strong>Use this token in the Authorization header for mutations:</strong> <code>Bearer %s</code></p>
+            %s
         </div>
 
         <div class="section">
@@ -93,6 +110,13 @@ func main() {
             <div class="code-block">curl -X POST http://localhost:7002/query -H "Content-Type: application/json" -H "Authorization: Bearer %s" -d '{"query": "mutation { likePost(postId: \"1\") { id likes } }"}'</div>
         </div>
 
+        <div class="section">
+            <h3>Subscriptions</h3>
+            <p>Connect to <code>ws://localhost:7002/query</code> using the <code>graphql-transport-ws</code> protocol and send the token in the <code>connection_init</code> payload as <code>{"Authorization": "Bearer &lt;token&gt;"}</code>.</p>
+            <div class="code-block">subscription { postCreated { id content author { name } } }
+subscription { postLiked(postId: "1") { id likes } }</div>
+        </div>
+
         <div class="section">
             <h3>Health Check</h3>
             <p><strong>URL:</strong> <a href="/health" class="url">http://localhost:7002/health</a></p>
@@ -105,7 +129,7 @@ func main() {
     </div>
 </body>
 </html>
-`, jwtToken, jwtToken, jwtToken, jwtToken)
+`, tokenSection, jwtToken, jwtToken)
 
 	// HTTP handler for GraphQL
 	http.HandleFunc("/playground", func(w http.ResponseWriter, r *http.Request) {
@@ -125,80 +149,39 @@ func main() {
 	})
 
 	// GraphQL endpoint
-	http.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != "POST" {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		var req struct {
-			Query         string                 `json:"query"`
-			Variables     map[string]interface{} `json:"variables"`
-			OperationName string                 `json:"operationName"`
-		}
-
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "Invalid JSON", http.StatusBadRequest)
-			return
-		}
-
-		// Check if it's a mutation (requires auth)
-		isMutation := strings.Contains(strings.ToLower(req.Query), "mutation")
-
-		var userID string
-		if isMutation {
-			// Extract JWT token from Authorization header for mutations
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusUnauthorized)
-				json.NewEncoder(w).Encode(map[string]interface{}{
-					"errors": []map[string]interface{}{
-						{"message": "Authorization required for mutations"},
-					},
-				})
-				return
-			}
-			token := strings.TrimPrefix(authHeader, "Bearer ")
-			userID = validateJWT(token)
-			if userID == "" {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusUnauthorized)
-				json.NewEncoder(w).Encode(map[string]interface{}{
-					"errors": []map[string]interface{}{
-						{"message": "Invalid token"},
-					},
-				})
-				return
-			}
-		}
-
-		// Create context with user info
-		ctx := context.Background()
-		if userID != "" {
-			ctx = context.WithValue(ctx, userContextKey, userID)
-		}
+	resolver := graph.NewResolver(grpcClient, userClient, notificationClient)
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers: resolver,
+	}))
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              websocketInit,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: maxUploadSize(),
+		MaxMemory:     1 << 20,
+	})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
 
-		// Add metadata for gRPC calls
-		md := metadata.Pairs("user-id", userID)
-		ctx = metadata.NewOutgoingContext(ctx, md)
+	// Authors and comments are batched per operation
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(resolver.WithLoaders(ctx))
+	})
 
-		// Handle GraphQL queries
-		result, err := executeGraphQLQuery(ctx, grpcClient, req.Query, req.Variables)
-		if err != nil {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusInternalServerError)
-			json.NewEncoder(w).Encode(map[string]interface{}{
-				"errors": []map[string]interface{}{
-					{"message": err.Error()},
-				},
-			})
-			return
-		}
+	http.Handle("/query", authMiddleware(srv))
 
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(result)
-	})
+	// Serve uploads kept on the local filesystem
+	storage.InitStorage()
+	if local, ok := storage.Store.(*storage.LocalStorage); ok {
+		http.Handle("/media/", http.StripPrefix("/media/", serveFiles(local.Dir())))
+	}
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -215,163 +198,87 @@ func main() {
 	}
 }
 
-func executeGraphQLQuery(ctx context.Context, grpcClient pb.PostServiceClient, query string, variables map[string]interface{}) (map[string]interface{}, error) {
-	// Handle GetPosts query (no auth required)
-	if strings.Contains(query, "getPosts") {
-		limit := int32(10)
-		if vars, ok := variables["limit"]; ok {
-			if l, ok := vars.(float64); ok {
-				limit = int32(l)
-			}
-		}
-
-		resp, err := grpcClient.GetPosts(ctx, &pb.GetPostsRequest{Limit: limit})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get posts: %v", err)
-		}
-
-		posts := make([]map[string]interface{}, len(resp.Posts))
-		for i, post := range resp.Posts {
-			posts[i] = convertProtoPostToGraphQL(post)
-		}
-
-		return map[string]interface{}{
-			"data": map[string]interface{}{
-				"getPosts": map[string]interface{}{
-					"posts": posts,
-				},
-			},
-		}, nil
-	}
+// authMiddleware validates the bearer token when one is supplied and puts the
+// caller into the request context. Resolvers decide which fields require auth.
+func authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
 
-	// Handle CreatePost mutation (auth required)
-	if strings.Contains(query, "createPost") {
-		content := ""
-		if vars, ok := variables["content"]; ok {
-			if c, ok := vars.(string); ok {
-				content = c
+		if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+			if !strings.HasPrefix(authHeader, "Bearer ") {
+				writeAuthError(w, "Authorization header must use the Bearer scheme")
+				return
 			}
-		}
-
-		if content == "" {
-			// Extract content from the query string
-			if strings.Contains(query, "content:") {
-				start := strings.Index(query, "content:") + 8
-				end := strings.Index(query[start:], "\"")
-				if end != -1 {
-					content = query[start : start+end]
-				}
+			claims, err := auth.ValidateToken(strings.TrimPrefix(authHeader, "Bearer "))
+			if err != nil {
+				writeAuthError(w, "Invalid token")
+				return
 			}
-		}
-
-		if content == "" {
-			return nil, fmt.Errorf("content is required")
-		}
+			ctx = auth.ContextWithUser(ctx, claims)
 
-		userID := getUserIDFromContext(ctx)
-		if userID == "" {
-			userID = "default-user"
+			// Forward the caller's token so the gRPC service can authenticate it
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authHeader)
 		}
 
-		resp, err := grpcClient.CreatePost(ctx, &pb.CreatePostRequest{
-			Content:  content,
-			AuthorId: userID,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to create post: %v", err)
-		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
-		return map[string]interface{}{
-			"data": map[string]interface{}{
-				"createPost": convertProtoPostToGraphQL(resp),
-			},
-		}, nil
+// websocketInit authenticates subscription connections from the
+// connection_init payload, since browsers cannot set headers on WebSockets.
+func websocketInit(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+	authHeader := initPayload.Authorization()
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return nil, nil, errors.New("authorization required for subscriptions")
 	}
 
-	// Handle LikePost mutation (auth required)
-	if strings.Contains(query, "likePost") {
-		postID := ""
-		if vars, ok := variables["postId"]; ok {
-			if id, ok := vars.(string); ok {
-				postID = id
-			}
-		}
-
-		if postID == "" {
-			// Extract postId from the query string
-			if strings.Contains(query, "postId:") {
-				start := strings.Index(query, "postId:") + 7
-				end := strings.Index(query[start:], "\"")
-				if end != -1 {
-					postID = query[start : start+end]
-				}
-			}
-		}
-
-		if postID == "" {
-			return nil, fmt.Errorf("postId is required")
-		}
-
-		userID := getUserIDFromContext(ctx)
-		if userID == "" {
-			userID = "default-user"
-		}
+	claims, err := auth.ValidateToken(strings.TrimPrefix(authHeader, "Bearer "))
+	if err != nil {
+		return nil, nil, errors.New("invalid token")
+	}
 
-		resp, err := grpcClient.LikePost(ctx, &pb.LikePostRequest{
-			PostId: postID,
-			UserId: userID,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to like post: %v", err)
-		}
+	ctx = auth.ContextWithUser(ctx, claims)
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", authHeader))
 
-		return map[string]interface{}{
-			"data": map[string]interface{}{
-				"likePost": convertProtoPostToGraphQL(resp),
-			},
-		}, nil
-	}
+	return ctx, &initPayload, nil
+}
 
-	return nil, fmt.Errorf("unsupported query: %s", query)
+func writeAuthError(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"message": message},
+		},
+	})
 }
 
-func convertProtoPostToGraphQL(post *pb.Post) map[string]interface{} {
-	var imageURL *string
-	if post.ImageUrl != nil {
-		imgURL := post.ImageUrl.Value
-		imageURL = &imgURL
-	}
+// devUserID identifies the user behind the development playground token
+const devUserID = "00000000-0000-0000-0000-000000000001"
 
-	return map[string]interface{}{
-		"id":        post.Id,
-		"content":   post.Content,
-		"author":    map[string]interface{}{"name": post.AuthorName},
-		"likes":     post.Likes,
-		"timestamp": post.Timestamp,
-		"imageUrl":  imageURL,
-	}
+// serveFiles serves the files below dir without listing directories
+func serveFiles(dir string) http.Handler {
+	files := http.FileServer(http.Dir(dir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "" || strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
 }
 
-func getUserIDFromContext(ctx context.Context) string {
-	if userID, ok := ctx.Value(userContextKey).(string); ok {
-		return userID
+// maxUploadSize bounds multipart requests, leaving room for the other
+// parts beyond the MEDIA_MAX_BYTES file limit the gRPC service enforces
+func maxUploadSize() int64 {
+	limit, err := strconv.ParseInt(os.Getenv("MEDIA_MAX_BYTES"), 10, 64)
+	if err != nil || limit <= 0 {
+		limit = 10 << 20
 	}
-	return ""
+	return limit + 1<<20
 }
 
-func generateJWT() string {
-	// Simple JWT token generation for testing
-	header := "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"
-	payload := "eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE2MzQ1Njc4OTYsImV4cCI6MTYzNDU3MTQ5Nn0"
-	signature := "test-signature-for-development"
-	
-	return header + "." + payload + "." + signature
-}
-
-func validateJWT(token string) string {
-	// Simple JWT validation for testing
-	if strings.Contains(token, "test-signature-for-development") {
-		return "test-user"
-	}
-	return ""
+// isDevelopment reports whether the gateway runs with ENV=development
+func isDevelopment() bool {
+	return os.Getenv("ENV") == "development"
 }
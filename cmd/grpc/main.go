@@ -6,6 +6,7 @@ import (
 	"muze/internal/database"
 	grpcService "muze/internal/grpc"
 	"muze/internal/messaging"
+	"muze/internal/storage"
 	pb "muze/proto"
 	"net"
 	"os"
@@ -25,14 +26,36 @@ func main() {
 	database.InitDB()
 	cache.InitRedis()
 	messaging.InitNATS()
+	storage.InitStorage()
 
-	// Create gRPC server
-	server := grpc.NewServer()
+	// Create gRPC server that authenticates callers from token metadata
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcService.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(grpcService.StreamAuthInterceptor),
+	)
 
 	// Register Post service
 	postServer := grpcService.NewPostServer()
 	pb.RegisterPostServiceServer(server, postServer)
 
+	// Keep follower timelines up to date as posts are created and deleted
+	if err := postServer.StartTimelineFanOut(); err != nil {
+		log.Fatalf("Failed to start timeline fan-out: %v", err)
+	}
+
+	// Register User service
+	userServer := grpcService.NewUserServer()
+	pb.RegisterUserServiceServer(server, userServer)
+
+	// Register Notification service
+	notificationServer := grpcService.NewNotificationServer()
+	pb.RegisterNotificationServiceServer(server, notificationServer)
+
+	// Turn post and follow events into notifications
+	if err := notificationServer.StartNotificationConsumer(); err != nil {
+		log.Fatalf("Failed to start notification consumer: %v", err)
+	}
+
 	// Enable reflection for debugging
 	reflection.Register(server)
 
@@ -0,0 +1,306 @@
+package graphql
+
+import (
+	pb "muze/proto"
+)
+
+// convertProtoPost converts a protobuf Post to the GraphQL Post type
+func convertProtoPost(p *pb.Post) *Post {
+	var imageURL *string
+	if p.ImageUrl != nil {
+		imageURL = &p.ImageUrl.Value
+	}
+
+	post := &Post{
+		ID:                  p.Id,
+		Content:             p.Content,
+		AuthorID:            p.AuthorId,
+		AuthorName:          p.AuthorName,
+		Likes:               int(p.Likes),
+		Timestamp:           p.Timestamp,
+		ImageURL:            imageURL,
+		Media:               convertProtoPostMedia(p.Media),
+		CommentCount:        int(p.CommentCount),
+		Entities:            convertProtoEntities(p.Entities),
+		Reactions:           convertProtoReactions(p.Reactions),
+		ViewerReactions:     p.ViewerReactions,
+		RepostCount:         int(p.RepostCount),
+		RepostOfID:          p.RepostOfId,
+		QuotedPostID:        p.QuotedPostId,
+		ReplyCount:          int(p.ReplyCount),
+		ViewerHasBookmarked: p.ViewerHasBookmarked,
+	}
+	if p.ParentId != "" {
+		parentID := p.ParentId
+		post.ParentID = &parentID
+	}
+	if p.RootId != "" {
+		rootID := p.RootId
+		post.RootID = &rootID
+	}
+	if p.RepostOf != nil {
+		post.repostOf = convertProtoPost(p.RepostOf)
+	}
+	if p.QuotedPost != nil {
+		post.quotedPost = convertProtoPost(p.QuotedPost)
+	}
+	return post
+}
+
+// convertThreadNode converts a protobuf ThreadNode and its replies to the
+// GraphQL ThreadNode type
+func convertThreadNode(n *pb.ThreadNode) *ThreadNode {
+	replies := make([]*ThreadNode, 0, len(n.Replies))
+	for _, reply := range n.Replies {
+		replies = append(replies, convertThreadNode(reply))
+	}
+	return &ThreadNode{
+		Post:     convertProtoPost(n.Post),
+		Replies:  replies,
+		PageInfo: convertPageInfo(n.PageInfo),
+	}
+}
+
+// convertProtoThread converts a GetThread response to the GraphQL Thread type
+func convertProtoThread(resp *pb.GetThreadResponse) *Thread {
+	ancestors := make([]*Post, 0, len(resp.Ancestors))
+	for _, ancestor := range resp.Ancestors {
+		ancestors = append(ancestors, convertProtoPost(ancestor))
+	}
+	return &Thread{
+		Ancestors: ancestors,
+		Tree:      convertThreadNode(resp.Thread),
+	}
+}
+
+// convertProtoReactions converts protobuf reaction counts to GraphQL ReactionCount values
+func convertProtoReactions(reactions []*pb.ReactionCount) []*ReactionCount {
+	result := make([]*ReactionCount, 0, len(reactions))
+	for _, r := range reactions {
+		result = append(result, &ReactionCount{Type: r.Type, Emoji: r.Emoji, Count: int(r.Count)})
+	}
+	return result
+}
+
+// convertProtoEntities converts protobuf post entities to GraphQL PostEntity values
+func convertProtoEntities(entities []*pb.PostEntity) []*PostEntity {
+	result := make([]*PostEntity, 0, len(entities))
+	for _, e := range entities {
+		entity := &PostEntity{
+			Type:  PostEntityTypeHashtag,
+			Text:  e.Text,
+			Start: int(e.Start),
+			End:   int(e.End),
+		}
+		if e.Type == pb.PostEntityType_POST_ENTITY_TYPE_MENTION {
+			entity.Type = PostEntityTypeMention
+			userID := e.UserId
+			entity.UserID = &userID
+		}
+		result = append(result, entity)
+	}
+	return result
+}
+
+// convertProtoPostMedia converts protobuf post attachments to GraphQL PostMedia values
+func convertProtoPostMedia(attachments []*pb.PostMedia) []*PostMedia {
+	result := make([]*PostMedia, 0, len(attachments))
+	for _, a := range attachments {
+		mediaType := MediaTypeImage
+		if a.Type == pb.PostMediaType_POST_MEDIA_TYPE_GIF {
+			mediaType = MediaTypeGif
+		}
+		result = append(result, newPostMedia(a.Id, mediaType, a.Url, a.ThumbnailUrl, int(a.Width), int(a.Height), a.Alt, a.Blurhash))
+	}
+	return result
+}
+
+// newPostMedia builds a PostMedia, leaving unknown values unset
+func newPostMedia(id string, mediaType MediaType, url, thumbnailURL string, width, height int, alt, blurhash string) *PostMedia {
+	media := &PostMedia{ID: id, Type: mediaType, URL: url}
+	if thumbnailURL != "" {
+		media.ThumbnailURL = &thumbnailURL
+	}
+	if width > 0 && height > 0 {
+		media.Width, media.Height = &width, &height
+	}
+	if alt != "" {
+		media.Alt = &alt
+	}
+	if blurhash != "" {
+		media.Blurhash = &blurhash
+	}
+	return media
+}
+
+// convertProtoComment converts a protobuf Comment to the GraphQL Comment type
+func convertProtoComment(c *pb.Comment) *Comment {
+	return &Comment{
+		ID:         c.Id,
+		Content:    c.Content,
+		AuthorID:   c.AuthorId,
+		AuthorName: c.AuthorName,
+		Timestamp:  c.Timestamp,
+	}
+}
+
+// convertProtoUser converts a protobuf User to the GraphQL User type
+func convertProtoUser(u *pb.User) *User {
+	var avatar, bio *string
+	if u.Avatar != nil {
+		avatar = &u.Avatar.Value
+	}
+	if u.Bio != nil {
+		bio = &u.Bio.Value
+	}
+
+	return &User{
+		ID:             u.Id,
+		Name:           u.Name,
+		Avatar:         avatar,
+		Bio:            bio,
+		FollowerCount:  int(u.FollowerCount),
+		FollowingCount: int(u.FollowingCount),
+	}
+}
+
+// convertPageInfo converts protobuf page info to the GraphQL PageInfo type
+func convertPageInfo(p *pb.PageInfo) *PageInfo {
+	pageInfo := &PageInfo{
+		HasNextPage:     p.GetHasNextPage(),
+		HasPreviousPage: p.GetHasPreviousPage(),
+	}
+	if p.GetStartCursor() != "" {
+		startCursor := p.StartCursor
+		pageInfo.StartCursor = &startCursor
+	}
+	if p.GetEndCursor() != "" {
+		endCursor := p.EndCursor
+		pageInfo.EndCursor = &endCursor
+	}
+	return pageInfo
+}
+
+// convertPostConnection converts a cursor-paged GetPosts response to a PostConnection
+func convertPostConnection(resp *pb.GetPostsResponse) *PostConnection {
+	edges := make([]*PostEdge, 0, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges = append(edges, &PostEdge{
+			Cursor: edge.Cursor,
+			Node:   convertProtoPost(edge.Post),
+		})
+	}
+
+	return &PostConnection{
+		Edges:    edges,
+		PageInfo: convertPageInfo(resp.PageInfo),
+	}
+}
+
+// convertCommentConnection converts a ListComments response to a CommentConnection
+func convertCommentConnection(resp *pb.ListCommentsResponse) *CommentConnection {
+	edges := make([]*CommentEdge, 0, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges = append(edges, &CommentEdge{
+			Cursor: edge.Cursor,
+			Node:   convertProtoComment(edge.Comment),
+		})
+	}
+
+	return &CommentConnection{
+		Edges:    edges,
+		PageInfo: convertPageInfo(resp.PageInfo),
+	}
+}
+
+// convertUserConnection converts a ListFollowers or ListFollowing response to a UserConnection
+func convertUserConnection(resp *pb.ListFollowsResponse) *UserConnection {
+	edges := make([]*UserEdge, 0, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges = append(edges, &UserEdge{
+			Cursor: edge.Cursor,
+			Node:   convertProtoUser(edge.User),
+		})
+	}
+
+	return &UserConnection{
+		Edges:    edges,
+		PageInfo: convertPageInfo(resp.PageInfo),
+	}
+}
+
+// convertSearchResultConnection converts a SearchPosts response to a SearchResultConnection
+func convertSearchResultConnection(resp *pb.SearchPostsResponse) *SearchResultConnection {
+	edges := make([]*SearchResultEdge, 0, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges = append(edges, &SearchResultEdge{
+			Cursor:  edge.Cursor,
+			Node:    convertProtoPost(edge.Post),
+			Snippet: edge.Snippet,
+			Rank:    float64(edge.Rank),
+		})
+	}
+
+	return &SearchResultConnection{
+		Edges:    edges,
+		PageInfo: convertPageInfo(resp.PageInfo),
+	}
+}
+
+var notificationTypes = map[pb.NotificationType]NotificationType{
+	pb.NotificationType_NOTIFICATION_TYPE_LIKE:    NotificationTypeLike,
+	pb.NotificationType_NOTIFICATION_TYPE_COMMENT: NotificationTypeComment,
+	pb.NotificationType_NOTIFICATION_TYPE_FOLLOW:  NotificationTypeFollow,
+	pb.NotificationType_NOTIFICATION_TYPE_MENTION: NotificationTypeMention,
+	pb.NotificationType_NOTIFICATION_TYPE_REPOST:  NotificationTypeRepost,
+}
+
+// convertProtoNotification converts a protobuf Notification to the GraphQL Notification type
+func convertProtoNotification(n *pb.Notification) *Notification {
+	var postID *string
+	if n.PostId != "" {
+		postID = &n.PostId
+	}
+
+	return &Notification{
+		ID:         n.Id,
+		Type:       notificationTypes[n.Type],
+		PostID:     postID,
+		ActorIDs:   n.ActorIds,
+		ActorCount: int(n.ActorCount),
+		Message:    n.Message,
+		Read:       n.Read,
+		Timestamp:  n.Timestamp,
+	}
+}
+
+// convertNotificationConnection converts a ListNotifications response to a NotificationConnection
+func convertNotificationConnection(resp *pb.ListNotificationsResponse) *NotificationConnection {
+	edges := make([]*NotificationEdge, 0, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges = append(edges, &NotificationEdge{
+			Cursor: edge.Cursor,
+			Node:   convertProtoNotification(edge.Notification),
+		})
+	}
+
+	return &NotificationConnection{
+		Edges:       edges,
+		PageInfo:    convertPageInfo(resp.PageInfo),
+		UnreadCount: int(resp.UnreadCount),
+	}
+}
+
+// convertProtoMedia converts a protobuf Media to the GraphQL Media type
+func convertProtoMedia(m *pb.Media) *Media {
+	return &Media{
+		ID:           m.Id,
+		URL:          m.Url,
+		ThumbnailURL: m.ThumbnailUrl,
+		ContentType:  m.ContentType,
+		Width:        int(m.Width),
+		Height:       int(m.Height),
+		Size:         int(m.Size),
+		Blurhash:     m.Blurhash,
+	}
+}
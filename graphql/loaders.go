@@ -0,0 +1,237 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "muze/proto"
+)
+
+// loaderBatchWait is how long a loader collects IDs before fetching them
+const loaderBatchWait = 2 * time.Millisecond
+
+// maxLoaderBatch matches the limit enforced by GetUsersByIds and
+// ListCommentsByPosts
+const maxLoaderBatch = 100
+
+type loadersKey struct{}
+
+// loaders holds the batch loaders of one operation
+type loaders struct {
+	users *batchLoader[*pb.User]
+
+	mu sync.Mutex
+	// comments has a loader per page size, since a batch shares one
+	comments map[int32]*batchLoader[*pb.ListCommentsResponse]
+}
+
+// batchLoader batches the lookups made while resolving one operation into
+// calls of fetch and remembers the results. fetch returns the values it
+// found by ID.
+type batchLoader[V any] struct {
+	fetchBatch func(ctx context.Context, ids []string) (map[string]V, error)
+
+	mu      sync.Mutex
+	results map[string]*loadResult[V]
+	batch   *loadBatch[V]
+}
+
+type loadResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type loadBatch[V any] struct {
+	ids     []string
+	results []*loadResult[V]
+}
+
+func newBatchLoader[V any](fetch func(ctx context.Context, ids []string) (map[string]V, error)) *batchLoader[V] {
+	return &batchLoader[V]{
+		fetchBatch: fetch,
+		results:    make(map[string]*loadResult[V]),
+	}
+}
+
+func newUserLoader(client pb.UserServiceClient) *batchLoader[*pb.User] {
+	return newBatchLoader(func(ctx context.Context, ids []string) (map[string]*pb.User, error) {
+		resp, err := client.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids})
+		if err != nil {
+			return nil, err
+		}
+
+		users := make(map[string]*pb.User)
+		for _, user := range resp.Users {
+			users[user.Id] = user
+		}
+		return users, nil
+	})
+}
+
+func newCommentLoader(client pb.PostServiceClient, first int32) *batchLoader[*pb.ListCommentsResponse] {
+	return newBatchLoader(func(ctx context.Context, ids []string) (map[string]*pb.ListCommentsResponse, error) {
+		resp, err := client.ListCommentsByPosts(ctx, &pb.ListCommentsByPostsRequest{PostIds: ids, First: first})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Pages, nil
+	})
+}
+
+// WithLoaders returns a context carrying fresh batch loaders. The gateway
+// installs them per operation so users and first pages of comments are
+// fetched at most once per request.
+func (r *Resolver) WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, r.newLoaders())
+}
+
+func (r *Resolver) newLoaders() *loaders {
+	return &loaders{
+		users:    newUserLoader(r.userClient),
+		comments: make(map[int32]*batchLoader[*pb.ListCommentsResponse]),
+	}
+}
+
+// loadersFor returns the operation's loaders, or new ones when the context
+// was not prepared with WithLoaders.
+func (r *Resolver) loadersFor(ctx context.Context) *loaders {
+	if l, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+		return l
+	}
+	return r.newLoaders()
+}
+
+// userLoaderFor returns the operation's user loader
+func (r *Resolver) userLoaderFor(ctx context.Context) *batchLoader[*pb.User] {
+	return r.loadersFor(ctx).users
+}
+
+// commentLoaderFor returns the operation's loader for first pages of
+// comments of the given size
+func (r *Resolver) commentLoaderFor(ctx context.Context, first int32) *batchLoader[*pb.ListCommentsResponse] {
+	l := r.loadersFor(ctx)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	loader, ok := l.comments[first]
+	if !ok {
+		loader = newCommentLoader(r.grpcClient, first)
+		l.comments[first] = loader
+	}
+	return loader
+}
+
+// resolveAuthor loads the author of a post or comment. Authors without a
+// profile keep the name stored with the content.
+func (r *Resolver) resolveAuthor(ctx context.Context, authorID, authorName string) (*User, error) {
+	user, err := r.userLoaderFor(ctx).Load(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return &User{ID: authorID, Name: authorName}, nil
+	}
+	return convertProtoUser(user), nil
+}
+
+// Load returns the value with the given ID, or the zero value if there is
+// none
+func (l *batchLoader[V]) Load(ctx context.Context, id string) (V, error) {
+	l.mu.Lock()
+	result, ok := l.results[id]
+	if !ok {
+		result = &loadResult[V]{done: make(chan struct{})}
+		l.results[id] = result
+		l.enqueue(ctx, id, result)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// LoadMany returns the values with the given IDs in the same order, with
+// the zero value for IDs that have none. The IDs are fetched in one batch.
+func (l *batchLoader[V]) LoadMany(ctx context.Context, ids []string) ([]V, error) {
+	pending := make([]*loadResult[V], len(ids))
+	l.mu.Lock()
+	for i, id := range ids {
+		result, ok := l.results[id]
+		if !ok {
+			result = &loadResult[V]{done: make(chan struct{})}
+			l.results[id] = result
+			l.enqueue(ctx, id, result)
+		}
+		pending[i] = result
+	}
+	l.mu.Unlock()
+
+	values := make([]V, len(ids))
+	for i, result := range pending {
+		select {
+		case <-result.done:
+			if result.err != nil {
+				return nil, result.err
+			}
+			values[i] = result.value
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return values, nil
+}
+
+// enqueue adds id to the pending batch, starting one if needed.
+// The caller must hold l.mu.
+func (l *batchLoader[V]) enqueue(ctx context.Context, id string, result *loadResult[V]) {
+	if l.batch == nil {
+		batch := &loadBatch[V]{}
+		l.batch = batch
+		time.AfterFunc(loaderBatchWait, func() {
+			l.mu.Lock()
+			if l.batch == batch {
+				l.batch = nil
+			}
+			l.mu.Unlock()
+			l.fetch(context.WithoutCancel(ctx), batch)
+		})
+	}
+
+	l.batch.ids = append(l.batch.ids, id)
+	l.batch.results = append(l.batch.results, result)
+
+	// Full batches are sent right away
+	if len(l.batch.ids) == maxLoaderBatch {
+		batch := l.batch
+		l.batch = nil
+		go l.fetch(context.WithoutCancel(ctx), batch)
+	}
+}
+
+func (l *batchLoader[V]) fetch(ctx context.Context, batch *loadBatch[V]) {
+	// A batch sent because it was full is also seen by its timer
+	l.mu.Lock()
+	if batch.ids == nil {
+		l.mu.Unlock()
+		return
+	}
+	ids, results := batch.ids, batch.results
+	batch.ids, batch.results = nil, nil
+	l.mu.Unlock()
+
+	values, err := l.fetchBatch(ctx, ids)
+
+	for i, id := range ids {
+		results[i].value = values[id]
+		results[i].err = err
+		close(results[i].done)
+	}
+}
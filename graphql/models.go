@@ -0,0 +1,52 @@
+package graphql
+
+// Post is bound in gqlgen.yml so the author is resolved from the user
+// service instead of being embedded in every post, and so are the posts
+// reposts and quotes share.
+type Post struct {
+	ID                  string           `json:"id"`
+	Content             string           `json:"content"`
+	AuthorID            string           `json:"-"`
+	AuthorName          string           `json:"-"`
+	Likes               int              `json:"likes"`
+	Timestamp           string           `json:"timestamp"`
+	ImageURL            *string          `json:"imageUrl,omitempty"`
+	Media               []*PostMedia     `json:"media"`
+	CommentCount        int              `json:"commentCount"`
+	Entities            []*PostEntity    `json:"entities"`
+	Reactions           []*ReactionCount `json:"reactions"`
+	ViewerReactions     []string         `json:"viewerReactions"`
+	RepostCount         int              `json:"repostCount"`
+	RepostOfID          string           `json:"-"`
+	QuotedPostID        string           `json:"-"`
+	ParentID            *string          `json:"parentId,omitempty"`
+	RootID              *string          `json:"rootId,omitempty"`
+	ReplyCount          int              `json:"replyCount"`
+	ViewerHasBookmarked bool             `json:"viewerHasBookmarked"`
+
+	// Shared posts embedded by the post service, resolved by ID otherwise
+	repostOf   *Post
+	quotedPost *Post
+}
+
+// Comment is bound in gqlgen.yml so its author is resolved like a post's.
+type Comment struct {
+	ID         string `json:"id"`
+	Content    string `json:"content"`
+	AuthorID   string `json:"-"`
+	AuthorName string `json:"-"`
+	Timestamp  string `json:"timestamp"`
+}
+
+// Notification is bound in gqlgen.yml so its actors are resolved through
+// the user loader.
+type Notification struct {
+	ID         string           `json:"id"`
+	Type       NotificationType `json:"type"`
+	PostID     *string          `json:"postId,omitempty"`
+	ActorIDs   []string         `json:"-"`
+	ActorCount int              `json:"actorCount"`
+	Message    string           `json:"message"`
+	Read       bool             `json:"read"`
+	Timestamp  string           `json:"timestamp"`
+}
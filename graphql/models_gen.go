@@ -0,0 +1,626 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type CommentConnection struct {
+	Edges    []*CommentEdge `json:"edges"`
+	PageInfo *PageInfo      `json:"pageInfo"`
+}
+
+type CommentEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Comment `json:"node"`
+}
+
+// An uploaded image, stripped of metadata
+type Media struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnailUrl"`
+	ContentType  string `json:"contentType"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	Size         int    `json:"size"`
+	// Placeholder to show while the image loads, see https://blurha.sh
+	Blurhash string `json:"blurhash"`
+}
+
+type MediaAttachmentInput struct {
+	// An upload of the caller's that no post uses yet
+	MediaID string  `json:"mediaId"`
+	Alt     *string `json:"alt,omitempty"`
+}
+
+// An entry in the audit trail of moderator decisions
+type ModerationAction struct {
+	ID          string               `json:"id"`
+	ModeratorID string               `json:"moderatorId"`
+	Type        ModerationActionType `json:"type"`
+	ReportID    *string              `json:"reportId,omitempty"`
+	PostID      *string              `json:"postId,omitempty"`
+	// The suspended user, for suspensions
+	UserID    *string `json:"userId,omitempty"`
+	Note      *string `json:"note,omitempty"`
+	Timestamp string  `json:"timestamp"`
+}
+
+type ModerationActionConnection struct {
+	Edges    []*ModerationActionEdge `json:"edges"`
+	PageInfo *PageInfo               `json:"pageInfo"`
+}
+
+type ModerationActionEdge struct {
+	Cursor string            `json:"cursor"`
+	Node   *ModerationAction `json:"node"`
+}
+
+type Mutation struct {
+}
+
+type NotificationConnection struct {
+	Edges       []*NotificationEdge `json:"edges"`
+	PageInfo    *PageInfo           `json:"pageInfo"`
+	UnreadCount int                 `json:"unreadCount"`
+}
+
+type NotificationEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *Notification `json:"node"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
+type PostConnection struct {
+	Edges    []*PostEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type PostEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Post  `json:"node"`
+}
+
+// A hashtag or mention in a post's content. start and end are offsets in Unicode code points, not UTF-16 code units like JavaScript string indexes, end exclusive, and include the # or @.
+type PostEntity struct {
+	Type PostEntityType `json:"type"`
+	// Text after the # or @, as written
+	Text  string `json:"text"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	// Mentioned user, set for mentions only
+	UserID *string `json:"userId,omitempty"`
+}
+
+// An attachment of a post
+type PostMedia struct {
+	ID   string    `json:"id"`
+	Type MediaType `json:"type"`
+	URL  string    `json:"url"`
+	// Unset for images linked by URL
+	ThumbnailURL *string `json:"thumbnailUrl,omitempty"`
+	// Unset for images linked by URL
+	Width *int `json:"width,omitempty"`
+	// Unset for images linked by URL
+	Height *int `json:"height,omitempty"`
+	// Description for screen readers
+	Alt      *string `json:"alt,omitempty"`
+	Blurhash *string `json:"blurhash,omitempty"`
+}
+
+type PostsResponse struct {
+	Posts []*Post `json:"posts"`
+	Total int     `json:"total"`
+}
+
+type Query struct {
+}
+
+type ReactionCount struct {
+	Type  string `json:"type"`
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+// One of the configured emoji reactions
+type ReactionType struct {
+	Type  string `json:"type"`
+	Emoji string `json:"emoji"`
+}
+
+// A user's report of a post
+type Report struct {
+	ID     string `json:"id"`
+	PostID string `json:"postId"`
+	// The reported post as moderators see it, including hidden and removed posts. Null for reporters.
+	Post       *Post        `json:"post,omitempty"`
+	ReporterID string       `json:"reporterId"`
+	Reason     ReportReason `json:"reason"`
+	Details    *string      `json:"details,omitempty"`
+	Status     ReportStatus `json:"status"`
+	// Set once resolved
+	Decision     *ModerationDecision `json:"decision,omitempty"`
+	ResolvedByID *string             `json:"resolvedById,omitempty"`
+	ResolvedAt   *string             `json:"resolvedAt,omitempty"`
+	CreatedAt    string              `json:"createdAt"`
+}
+
+type ReportConnection struct {
+	Edges    []*ReportEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type ReportEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Report `json:"node"`
+}
+
+type SearchResultConnection struct {
+	Edges    []*SearchResultEdge `json:"edges"`
+	PageInfo *PageInfo           `json:"pageInfo"`
+}
+
+type SearchResultEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Post  `json:"node"`
+	// Excerpt of the content, HTML-escaped, with matches wrapped in <mark>
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
+
+type Subscription struct {
+}
+
+// The conversation around a post
+type Thread struct {
+	// Posts replied to, from the root of the thread down to the parent
+	Ancestors []*Post     `json:"ancestors"`
+	Tree      *ThreadNode `json:"tree"`
+}
+
+// A post with its first replies, oldest first. Posts at the depth limit, or past the 200 replies a thread lists, list no or only some replies; pageInfo.hasNextPage tells whether there are more.
+type ThreadNode struct {
+	Post     *Post         `json:"post"`
+	Replies  []*ThreadNode `json:"replies"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type User struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Avatar         *string `json:"avatar,omitempty"`
+	Bio            *string `json:"bio,omitempty"`
+	FollowerCount  int     `json:"followerCount"`
+	FollowingCount int     `json:"followingCount"`
+}
+
+type UserConnection struct {
+	Edges    []*UserEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type UserEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *User  `json:"node"`
+}
+
+type MediaType string
+
+const (
+	MediaTypeImage MediaType = "IMAGE"
+	MediaTypeGif   MediaType = "GIF"
+)
+
+var AllMediaType = []MediaType{
+	MediaTypeImage,
+	MediaTypeGif,
+}
+
+func (e MediaType) IsValid() bool {
+	switch e {
+	case MediaTypeImage, MediaTypeGif:
+		return true
+	}
+	return false
+}
+
+func (e MediaType) String() string {
+	return string(e)
+}
+
+func (e *MediaType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MediaType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MediaType", str)
+	}
+	return nil
+}
+
+func (e MediaType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MediaType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MediaType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ModerationActionType string
+
+const (
+	ModerationActionTypeDismiss       ModerationActionType = "DISMISS"
+	ModerationActionTypeHidePost      ModerationActionType = "HIDE_POST"
+	ModerationActionTypeRemovePost    ModerationActionType = "REMOVE_POST"
+	ModerationActionTypeSuspendAuthor ModerationActionType = "SUSPEND_AUTHOR"
+)
+
+var AllModerationActionType = []ModerationActionType{
+	ModerationActionTypeDismiss,
+	ModerationActionTypeHidePost,
+	ModerationActionTypeRemovePost,
+	ModerationActionTypeSuspendAuthor,
+}
+
+func (e ModerationActionType) IsValid() bool {
+	switch e {
+	case ModerationActionTypeDismiss, ModerationActionTypeHidePost, ModerationActionTypeRemovePost, ModerationActionTypeSuspendAuthor:
+		return true
+	}
+	return false
+}
+
+func (e ModerationActionType) String() string {
+	return string(e)
+}
+
+func (e *ModerationActionType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationActionType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationActionType", str)
+	}
+	return nil
+}
+
+func (e ModerationActionType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ModerationActionType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ModerationActionType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ModerationDecision string
+
+const (
+	// Leave the post as it is
+	ModerationDecisionDismiss ModerationDecision = "DISMISS"
+	// Hide the post and its reposts from everyone
+	ModerationDecisionHidePost ModerationDecision = "HIDE_POST"
+	// Delete the post like its author would
+	ModerationDecisionRemovePost ModerationDecision = "REMOVE_POST"
+)
+
+var AllModerationDecision = []ModerationDecision{
+	ModerationDecisionDismiss,
+	ModerationDecisionHidePost,
+	ModerationDecisionRemovePost,
+}
+
+func (e ModerationDecision) IsValid() bool {
+	switch e {
+	case ModerationDecisionDismiss, ModerationDecisionHidePost, ModerationDecisionRemovePost:
+		return true
+	}
+	return false
+}
+
+func (e ModerationDecision) String() string {
+	return string(e)
+}
+
+func (e *ModerationDecision) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationDecision(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationDecision", str)
+	}
+	return nil
+}
+
+func (e ModerationDecision) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ModerationDecision) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ModerationDecision) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationType string
+
+const (
+	NotificationTypeLike    NotificationType = "LIKE"
+	NotificationTypeComment NotificationType = "COMMENT"
+	NotificationTypeFollow  NotificationType = "FOLLOW"
+	NotificationTypeMention NotificationType = "MENTION"
+	NotificationTypeRepost  NotificationType = "REPOST"
+)
+
+var AllNotificationType = []NotificationType{
+	NotificationTypeLike,
+	NotificationTypeComment,
+	NotificationTypeFollow,
+	NotificationTypeMention,
+	NotificationTypeRepost,
+}
+
+func (e NotificationType) IsValid() bool {
+	switch e {
+	case NotificationTypeLike, NotificationTypeComment, NotificationTypeFollow, NotificationTypeMention, NotificationTypeRepost:
+		return true
+	}
+	return false
+}
+
+func (e NotificationType) String() string {
+	return string(e)
+}
+
+func (e *NotificationType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationType", str)
+	}
+	return nil
+}
+
+func (e NotificationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PostEntityType string
+
+const (
+	PostEntityTypeHashtag PostEntityType = "HASHTAG"
+	PostEntityTypeMention PostEntityType = "MENTION"
+)
+
+var AllPostEntityType = []PostEntityType{
+	PostEntityTypeHashtag,
+	PostEntityTypeMention,
+}
+
+func (e PostEntityType) IsValid() bool {
+	switch e {
+	case PostEntityTypeHashtag, PostEntityTypeMention:
+		return true
+	}
+	return false
+}
+
+func (e PostEntityType) String() string {
+	return string(e)
+}
+
+func (e *PostEntityType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostEntityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostEntityType", str)
+	}
+	return nil
+}
+
+func (e PostEntityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PostEntityType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PostEntityType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportReason string
+
+const (
+	ReportReasonSpam           ReportReason = "SPAM"
+	ReportReasonHarassment     ReportReason = "HARASSMENT"
+	ReportReasonHate           ReportReason = "HATE"
+	ReportReasonViolence       ReportReason = "VIOLENCE"
+	ReportReasonNudity         ReportReason = "NUDITY"
+	ReportReasonMisinformation ReportReason = "MISINFORMATION"
+	ReportReasonOther          ReportReason = "OTHER"
+)
+
+var AllReportReason = []ReportReason{
+	ReportReasonSpam,
+	ReportReasonHarassment,
+	ReportReasonHate,
+	ReportReasonViolence,
+	ReportReasonNudity,
+	ReportReasonMisinformation,
+	ReportReasonOther,
+}
+
+func (e ReportReason) IsValid() bool {
+	switch e {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonHate, ReportReasonViolence, ReportReasonNudity, ReportReasonMisinformation, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+func (e ReportReason) String() string {
+	return string(e)
+}
+
+func (e *ReportReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportReason", str)
+	}
+	return nil
+}
+
+func (e ReportReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportStatus string
+
+const (
+	ReportStatusOpen     ReportStatus = "OPEN"
+	ReportStatusResolved ReportStatus = "RESOLVED"
+)
+
+var AllReportStatus = []ReportStatus{
+	ReportStatusOpen,
+	ReportStatusResolved,
+}
+
+func (e ReportStatus) IsValid() bool {
+	switch e {
+	case ReportStatusOpen, ReportStatusResolved:
+		return true
+	}
+	return false
+}
+
+func (e ReportStatus) String() string {
+	return string(e)
+}
+
+func (e *ReportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportStatus", str)
+	}
+	return nil
+}
+
+func (e ReportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	pb "muze/proto"
+)
+
+var reportReasons = map[pb.ReportReason]ReportReason{
+	pb.ReportReason_REPORT_REASON_SPAM:           ReportReasonSpam,
+	pb.ReportReason_REPORT_REASON_HARASSMENT:     ReportReasonHarassment,
+	pb.ReportReason_REPORT_REASON_HATE:           ReportReasonHate,
+	pb.ReportReason_REPORT_REASON_VIOLENCE:       ReportReasonViolence,
+	pb.ReportReason_REPORT_REASON_NUDITY:         ReportReasonNudity,
+	pb.ReportReason_REPORT_REASON_MISINFORMATION: ReportReasonMisinformation,
+	pb.ReportReason_REPORT_REASON_OTHER:          ReportReasonOther,
+}
+
+var moderationDecisions = map[pb.ModerationDecision]ModerationDecision{
+	pb.ModerationDecision_MODERATION_DECISION_DISMISS:     ModerationDecisionDismiss,
+	pb.ModerationDecision_MODERATION_DECISION_HIDE_POST:   ModerationDecisionHidePost,
+	pb.ModerationDecision_MODERATION_DECISION_REMOVE_POST: ModerationDecisionRemovePost,
+}
+
+var moderationActionTypes = map[pb.ModerationActionType]ModerationActionType{
+	pb.ModerationActionType_MODERATION_ACTION_TYPE_DISMISS:        ModerationActionTypeDismiss,
+	pb.ModerationActionType_MODERATION_ACTION_TYPE_HIDE_POST:      ModerationActionTypeHidePost,
+	pb.ModerationActionType_MODERATION_ACTION_TYPE_REMOVE_POST:    ModerationActionTypeRemovePost,
+	pb.ModerationActionType_MODERATION_ACTION_TYPE_SUSPEND_AUTHOR: ModerationActionTypeSuspendAuthor,
+}
+
+// protoValue returns the protobuf enum value that maps to value, or the
+// zero (unspecified) value
+func protoValue[P comparable, G comparable](values map[P]G, value G) P {
+	for p, g := range values {
+		if g == value {
+			return p
+		}
+	}
+	var unspecified P
+	return unspecified
+}
+
+// optionalString returns nil for empty strings
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// convertProtoReport converts a protobuf Report to the GraphQL Report type
+func convertProtoReport(r *pb.Report) *Report {
+	report := &Report{
+		ID:           r.Id,
+		PostID:       r.PostId,
+		ReporterID:   r.ReporterId,
+		Reason:       reportReasons[r.Reason],
+		Details:      optionalString(r.Details),
+		Status:       ReportStatusOpen,
+		ResolvedByID: optionalString(r.ResolvedBy),
+		ResolvedAt:   optionalString(r.ResolvedAt),
+		CreatedAt:    r.CreatedAt,
+	}
+	if r.Status == pb.ReportStatus_REPORT_STATUS_RESOLVED {
+		report.Status = ReportStatusResolved
+	}
+	if decision, ok := moderationDecisions[r.Decision]; ok {
+		report.Decision = &decision
+	}
+	if r.Post != nil {
+		report.Post = convertProtoPost(r.Post)
+	}
+	return report
+}
+
+// convertReportConnection converts a ListReports response to a ReportConnection
+func convertReportConnection(resp *pb.ListReportsResponse) *ReportConnection {
+	edges := make([]*ReportEdge, 0, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges = append(edges, &ReportEdge{
+			Cursor: edge.Cursor,
+			Node:   convertProtoReport(edge.Report),
+		})
+	}
+
+	return &ReportConnection{
+		Edges:    edges,
+		PageInfo: convertPageInfo(resp.PageInfo),
+	}
+}
+
+// convertProtoModerationAction converts a protobuf ModerationAction to the GraphQL ModerationAction type
+func convertProtoModerationAction(a *pb.ModerationAction) *ModerationAction {
+	return &ModerationAction{
+		ID:          a.Id,
+		ModeratorID: a.ModeratorId,
+		Type:        moderationActionTypes[a.Type],
+		ReportID:    optionalString(a.ReportId),
+		PostID:      optionalString(a.PostId),
+		UserID:      optionalString(a.UserId),
+		Note:        optionalString(a.Note),
+		Timestamp:   a.CreatedAt,
+	}
+}
+
+// convertModerationActionConnection converts a ListModerationActions response to a ModerationActionConnection
+func convertModerationActionConnection(resp *pb.ListModerationActionsResponse) *ModerationActionConnection {
+	edges := make([]*ModerationActionEdge, 0, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges = append(edges, &ModerationActionEdge{
+			Cursor: edge.Cursor,
+			Node:   convertProtoModerationAction(edge.Action),
+		})
+	}
+
+	return &ModerationActionConnection{
+		Edges:    edges,
+		PageInfo: convertPageInfo(resp.PageInfo),
+	}
+}
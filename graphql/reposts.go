@@ -0,0 +1,28 @@
+package graphql
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "muze/proto"
+)
+
+// resolveSharedPost returns the post a repost or quote shares. Queries get
+// it embedded by the post service; posts from subscriptions only carry the
+// ID. Deleted posts resolve to null.
+func (r *Resolver) resolveSharedPost(ctx context.Context, id string, embedded *Post) (*Post, error) {
+	if embedded != nil || id == "" {
+		return embedded, nil
+	}
+
+	resp, err := r.grpcClient.GetPostById(ctx, &pb.GetPostByIdRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertProtoPost(resp), nil
+}
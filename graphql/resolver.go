@@ -8,6 +8,21 @@ import (
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
+// defaultPageSize is used when a connection field is queried without first
+const defaultPageSize = 10
+
 type Resolver struct {
 	grpcClient pb.PostServiceClient
+	userClient pb.UserServiceClient
+
+	notificationClient pb.NotificationServiceClient
+}
+
+// NewResolver creates a resolver backed by the given gRPC clients
+func NewResolver(grpcClient pb.PostServiceClient, userClient pb.UserServiceClient, notificationClient pb.NotificationServiceClient) *Resolver {
+	return &Resolver{
+		grpcClient:         grpcClient,
+		userClient:         userClient,
+		notificationClient: notificationClient,
+	}
 }
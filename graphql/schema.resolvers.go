@@ -0,0 +1,826 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+	"muze/internal/auth"
+	pb "muze/proto"
+
+	"github.com/99designs/gqlgen/graphql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Author is the resolver for the author field.
+func (r *commentResolver) Author(ctx context.Context, obj *Comment) (*User, error) {
+	return r.resolveAuthor(ctx, obj.AuthorID, obj.AuthorName)
+}
+
+// CreatePost is the resolver for the createPost field.
+func (r *mutationResolver) CreatePost(ctx context.Context, content string, imageURL *string, mediaID *string, media []*MediaAttachmentInput, quotedPostID *string, parentID *string) (*Post, error) {
+	// Get user from context (JWT token)
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Convert ImageURL to protobuf format
+	var protoImageURL *wrapperspb.StringValue
+	if imageURL != nil {
+		protoImageURL = wrapperspb.String(*imageURL)
+	}
+
+	attachments := make([]*pb.MediaAttachment, 0, len(media))
+	for _, attachment := range media {
+		attachments = append(attachments, &pb.MediaAttachment{MediaId: attachment.MediaID, Alt: stringValue(attachment.Alt)})
+	}
+
+	// Call gRPC service
+	resp, err := r.grpcClient.CreatePost(ctx, &pb.CreatePostRequest{
+		Content:      content,
+		AuthorId:     claims.UserID,
+		ImageUrl:     protoImageURL,
+		MediaId:      stringValue(mediaID),
+		Media:        attachments,
+		QuotedPostId: stringValue(quotedPostID),
+		ParentId:     stringValue(parentID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// UploadMedia is the resolver for the uploadMedia field.
+func (r *mutationResolver) UploadMedia(ctx context.Context, file graphql.Upload) (*Media, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.streamUpload(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoMedia(resp), nil
+}
+
+// LikePost is the resolver for the likePost field.
+func (r *mutationResolver) LikePost(ctx context.Context, postID string) (*Post, error) {
+	// Get user from context (JWT token)
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.grpcClient.LikePost(ctx, &pb.LikePostRequest{
+		PostId: postID,
+		UserId: claims.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// UnlikePost is the resolver for the unlikePost field.
+func (r *mutationResolver) UnlikePost(ctx context.Context, postID string) (*Post, error) {
+	// Get user from context (JWT token)
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.grpcClient.UnlikePost(ctx, &pb.UnlikePostRequest{
+		PostId: postID,
+		UserId: claims.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// AddReaction is the resolver for the addReaction field.
+func (r *mutationResolver) AddReaction(ctx context.Context, postID string, typeArg string) (*Post, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.AddReaction(ctx, &pb.ReactionRequest{PostId: postID, Type: typeArg})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// RemoveReaction is the resolver for the removeReaction field.
+func (r *mutationResolver) RemoveReaction(ctx context.Context, postID string, typeArg string) (*Post, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.RemoveReaction(ctx, &pb.ReactionRequest{PostId: postID, Type: typeArg})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// Repost is the resolver for the repost field.
+func (r *mutationResolver) Repost(ctx context.Context, postID string) (*Post, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.Repost(ctx, &pb.RepostRequest{PostId: postID})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// UndoRepost is the resolver for the undoRepost field.
+func (r *mutationResolver) UndoRepost(ctx context.Context, postID string) (*Post, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.UndoRepost(ctx, &pb.RepostRequest{PostId: postID})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// Bookmark is the resolver for the bookmark field.
+func (r *mutationResolver) Bookmark(ctx context.Context, postID string) (*Post, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.Bookmark(ctx, &pb.BookmarkRequest{PostId: postID})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// Unbookmark is the resolver for the unbookmark field.
+func (r *mutationResolver) Unbookmark(ctx context.Context, postID string) (*Post, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.Unbookmark(ctx, &pb.BookmarkRequest{PostId: postID})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// ReportPost is the resolver for the reportPost field.
+func (r *mutationResolver) ReportPost(ctx context.Context, postID string, reason ReportReason, details *string) (*Report, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.ReportPost(ctx, &pb.ReportPostRequest{
+		PostId:  postID,
+		Reason:  protoValue(reportReasons, reason),
+		Details: stringValue(details),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoReport(resp), nil
+}
+
+// ResolveReport is the resolver for the resolveReport field.
+func (r *mutationResolver) ResolveReport(ctx context.Context, id string, decision ModerationDecision, suspendAuthor *bool, note *string) (*Report, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.grpcClient.ResolveReport(ctx, &pb.ResolveReportRequest{
+		ReportId:      id,
+		Decision:      protoValue(moderationDecisions, decision),
+		SuspendAuthor: suspendAuthor != nil && *suspendAuthor,
+		Note:          stringValue(note),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoReport(resp), nil
+}
+
+// UpdatePost is the resolver for the updatePost field.
+func (r *mutationResolver) UpdatePost(ctx context.Context, id string, content *string, imageURL *string) (*Post, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Only the fields that were supplied are changed
+	req := &pb.UpdatePostRequest{Id: id}
+	if content != nil {
+		req.Content = wrapperspb.String(*content)
+	}
+	if imageURL != nil {
+		req.ImageUrl = wrapperspb.String(*imageURL)
+	}
+
+	// Call gRPC service
+	resp, err := r.grpcClient.UpdatePost(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// DeletePost is the resolver for the deletePost field.
+func (r *mutationResolver) DeletePost(ctx context.Context, id string) (string, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return "", fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.grpcClient.DeletePost(ctx, &pb.DeletePostRequest{Id: id})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Id, nil
+}
+
+// UpdateProfile is the resolver for the updateProfile field.
+func (r *mutationResolver) UpdateProfile(ctx context.Context, name *string, avatar *string, bio *string) (*User, error) {
+	// Get user from context (JWT token)
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Only the fields that were supplied are changed; empty strings clear them
+	req := &pb.UpdateProfileRequest{}
+	if name != nil {
+		req.Name = wrapperspb.String(*name)
+	}
+	if avatar != nil {
+		req.Avatar = wrapperspb.String(*avatar)
+	}
+	if bio != nil {
+		req.Bio = wrapperspb.String(*bio)
+	}
+
+	resp, err := r.userClient.UpdateProfile(ctx, req)
+	if status.Code(err) == codes.NotFound {
+		// First edit creates the profile, named after the token if no name is given
+		createReq := &pb.CreateUserRequest{Name: claims.Name, Avatar: req.Avatar, Bio: req.Bio}
+		if name != nil {
+			createReq.Name = *name
+		}
+		resp, err = r.userClient.CreateUser(ctx, createReq)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoUser(resp), nil
+}
+
+// CreateComment is the resolver for the createComment field.
+func (r *mutationResolver) CreateComment(ctx context.Context, postID string, content string) (*Comment, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.grpcClient.CreateComment(ctx, &pb.CreateCommentRequest{
+		PostId:  postID,
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoComment(resp), nil
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (string, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return "", fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.grpcClient.DeleteComment(ctx, &pb.DeleteCommentRequest{Id: id})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Id, nil
+}
+
+// Follow is the resolver for the follow field.
+func (r *mutationResolver) Follow(ctx context.Context, userID string) (bool, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return false, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.userClient.Follow(ctx, &pb.FollowRequest{UserId: userID})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Following, nil
+}
+
+// Unfollow is the resolver for the unfollow field.
+func (r *mutationResolver) Unfollow(ctx context.Context, userID string) (bool, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return false, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.userClient.Unfollow(ctx, &pb.FollowRequest{UserId: userID})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Following, nil
+}
+
+// MarkNotificationsRead is the resolver for the markNotificationsRead field.
+func (r *mutationResolver) MarkNotificationsRead(ctx context.Context, ids []string) (int, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return 0, fmt.Errorf("authentication required: %v", err)
+	}
+
+	// Call gRPC service
+	resp, err := r.notificationClient.MarkNotificationsRead(ctx, &pb.MarkNotificationsReadRequest{Ids: ids})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.UnreadCount), nil
+}
+
+// Actors is the resolver for the actors field.
+func (r *notificationResolver) Actors(ctx context.Context, obj *Notification) ([]*User, error) {
+	users, err := r.userLoaderFor(ctx).LoadMany(ctx, obj.ActorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Actors whose accounts are gone are left out
+	actors := make([]*User, 0, len(users))
+	for _, user := range users {
+		if user != nil {
+			actors = append(actors, convertProtoUser(user))
+		}
+	}
+	return actors, nil
+}
+
+// Author is the resolver for the author field.
+func (r *postResolver) Author(ctx context.Context, obj *Post) (*User, error) {
+	return r.resolveAuthor(ctx, obj.AuthorID, obj.AuthorName)
+}
+
+// Comments is the resolver for the comments field.
+func (r *postResolver) Comments(ctx context.Context, obj *Post, first *int, after *string) (*CommentConnection, error) {
+	req := &pb.ListCommentsRequest{PostId: obj.ID, First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+
+	// First pages are batched across the posts of the operation
+	if after == nil {
+		resp, err := r.commentLoaderFor(ctx, req.First).Load(ctx, obj.ID)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return convertCommentConnection(resp), nil
+	}
+
+	req.After = *after
+	resp, err := r.grpcClient.ListComments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertCommentConnection(resp), nil
+}
+
+// RepostOf is the resolver for the repostOf field.
+func (r *postResolver) RepostOf(ctx context.Context, obj *Post) (*Post, error) {
+	return r.resolveSharedPost(ctx, obj.RepostOfID, obj.repostOf)
+}
+
+// QuotedPost is the resolver for the quotedPost field.
+func (r *postResolver) QuotedPost(ctx context.Context, obj *Post) (*Post, error) {
+	return r.resolveSharedPost(ctx, obj.QuotedPostID, obj.quotedPost)
+}
+
+// GetPosts is the resolver for the getPosts field.
+func (r *queryResolver) GetPosts(ctx context.Context, limit *int, offset *int) (*PostsResponse, error) {
+	// The gRPC service serves pages from its feed cache
+	req := &pb.GetPostsRequest{}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if offset != nil {
+		req.Offset = int32(*offset)
+	}
+
+	resp, err := r.grpcClient.GetPosts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var posts []*Post
+	for _, p := range resp.Posts {
+		posts = append(posts, convertProtoPost(p))
+	}
+
+	return &PostsResponse{Posts: posts, Total: int(resp.Total)}, nil
+}
+
+// Posts is the resolver for the posts field.
+func (r *queryResolver) Posts(ctx context.Context, first *int, after *string) (*PostConnection, error) {
+	req := &pb.GetPostsRequest{First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.GetPosts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPostConnection(resp), nil
+}
+
+// GetPostByID is the resolver for the getPostById field.
+func (r *queryResolver) GetPostByID(ctx context.Context, id string) (*Post, error) {
+	// The post service caches posts; asking it keeps reactions and the
+	// viewer's own reactions current
+	resp, err := r.grpcClient.GetPostById(ctx, &pb.GetPostByIdRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPost(resp), nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*User, error) {
+	resp, err := r.userClient.GetUser(ctx, &pb.GetUserRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoUser(resp), nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*User, error) {
+	// Get user from context (JWT token)
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	return r.User(ctx, claims.UserID)
+}
+
+// HomeFeed is the resolver for the homeFeed field.
+func (r *queryResolver) HomeFeed(ctx context.Context, first *int, after *string) (*PostConnection, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	req := &pb.HomeFeedRequest{First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.HomeFeed(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPostConnection(resp), nil
+}
+
+// SearchPosts is the resolver for the searchPosts field.
+func (r *queryResolver) SearchPosts(ctx context.Context, query string, first *int, after *string) (*SearchResultConnection, error) {
+	req := &pb.SearchPostsRequest{Query: query, First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.SearchPosts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSearchResultConnection(resp), nil
+}
+
+// PostsByTag is the resolver for the postsByTag field.
+func (r *queryResolver) PostsByTag(ctx context.Context, tag string, first *int, after *string) (*PostConnection, error) {
+	req := &pb.PostsByHashtagRequest{Tag: tag, First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.PostsByHashtag(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPostConnection(resp), nil
+}
+
+// Notifications is the resolver for the notifications field.
+func (r *queryResolver) Notifications(ctx context.Context, first *int, after *string) (*NotificationConnection, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	req := &pb.ListNotificationsRequest{First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.notificationClient.ListNotifications(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertNotificationConnection(resp), nil
+}
+
+// UnreadNotificationCount is the resolver for the unreadNotificationCount field.
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return 0, fmt.Errorf("authentication required: %v", err)
+	}
+
+	resp, err := r.notificationClient.GetUnreadCount(ctx, &pb.GetUnreadCountRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.UnreadCount), nil
+}
+
+// ReactionTypes is the resolver for the reactionTypes field.
+func (r *queryResolver) ReactionTypes(ctx context.Context) ([]*ReactionType, error) {
+	resp, err := r.grpcClient.ListReactionTypes(ctx, &pb.ListReactionTypesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]*ReactionType, 0, len(resp.Types))
+	for _, t := range resp.Types {
+		types = append(types, &ReactionType{Type: t.Type, Emoji: t.Emoji})
+	}
+	return types, nil
+}
+
+// Thread is the resolver for the thread field.
+func (r *queryResolver) Thread(ctx context.Context, postID string, depth *int, first *int, after *string) (*Thread, error) {
+	req := &pb.GetThreadRequest{PostId: postID, First: int32(defaultPageSize)}
+	if depth != nil && *depth > 0 {
+		req.Depth = int32(*depth)
+	}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.GetThread(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoThread(resp), nil
+}
+
+// Bookmarks is the resolver for the bookmarks field.
+func (r *queryResolver) Bookmarks(ctx context.Context, first *int, after *string) (*PostConnection, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	req := &pb.ListBookmarksRequest{First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.ListBookmarks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPostConnection(resp), nil
+}
+
+// Reports is the resolver for the reports field.
+func (r *queryResolver) Reports(ctx context.Context, status *ReportStatus, first *int, after *string) (*ReportConnection, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	req := &pb.ListReportsRequest{Status: pb.ReportStatus_REPORT_STATUS_OPEN, First: int32(defaultPageSize)}
+	if status != nil && *status == ReportStatusResolved {
+		req.Status = pb.ReportStatus_REPORT_STATUS_RESOLVED
+	}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.ListReports(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertReportConnection(resp), nil
+}
+
+// ModerationLog is the resolver for the moderationLog field.
+func (r *queryResolver) ModerationLog(ctx context.Context, first *int, after *string) (*ModerationActionConnection, error) {
+	// Get user from context (JWT token)
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	req := &pb.ListModerationActionsRequest{First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.grpcClient.ListModerationActions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertModerationActionConnection(resp), nil
+}
+
+// PostCreated is the resolver for the postCreated field.
+func (r *subscriptionResolver) PostCreated(ctx context.Context) (<-chan *Post, error) {
+	return r.streamPostEvents(ctx, pb.PostEventType_POST_EVENT_TYPE_CREATED, &pb.StreamPostsRequest{})
+}
+
+// PostLiked is the resolver for the postLiked field.
+func (r *subscriptionResolver) PostLiked(ctx context.Context, postID string) (<-chan *Post, error) {
+	return r.streamPostEvents(ctx, pb.PostEventType_POST_EVENT_TYPE_LIKED, &pb.StreamPostsRequest{
+		PostIds: []string{postID},
+	})
+}
+
+// NotificationReceived is the resolver for the notificationReceived field.
+func (r *subscriptionResolver) NotificationReceived(ctx context.Context) (<-chan *Notification, error) {
+	return r.streamNotifications(ctx)
+}
+
+// Followers is the resolver for the followers field.
+func (r *userResolver) Followers(ctx context.Context, obj *User, first *int, after *string) (*UserConnection, error) {
+	req := &pb.ListFollowsRequest{UserId: obj.ID, First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.userClient.ListFollowers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertUserConnection(resp), nil
+}
+
+// Following is the resolver for the following field.
+func (r *userResolver) Following(ctx context.Context, obj *User, first *int, after *string) (*UserConnection, error) {
+	req := &pb.ListFollowsRequest{UserId: obj.ID, First: int32(defaultPageSize)}
+	if first != nil && *first > 0 {
+		req.First = int32(*first)
+	}
+	if after != nil {
+		req.After = *after
+	}
+
+	resp, err := r.userClient.ListFollowing(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertUserConnection(resp), nil
+}
+
+// Comment returns CommentResolver implementation.
+func (r *Resolver) Comment() CommentResolver { return &commentResolver{r} }
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// Notification returns NotificationResolver implementation.
+func (r *Resolver) Notification() NotificationResolver { return &notificationResolver{r} }
+
+// Post returns PostResolver implementation.
+func (r *Resolver) Post() PostResolver { return &postResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type commentResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type notificationResolver struct{ *Resolver }
+type postResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
@@ -0,0 +1,91 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"muze/internal/auth"
+	pb "muze/proto"
+)
+
+// streamPostEvents bridges the gRPC StreamPosts stream to a GraphQL
+// subscription channel, forwarding the events of the given type.
+// The channel is closed when the stream ends or ctx is cancelled.
+func (r *Resolver) streamPostEvents(ctx context.Context, eventType pb.PostEventType, req *pb.StreamPostsRequest) (<-chan *Post, error) {
+	// Subscriptions are authenticated on connection_init
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	req.UserId = claims.UserID
+	stream, err := r.grpcClient.StreamPosts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make(chan *Post, 1)
+	go func() {
+		defer close(posts)
+
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Printf("Post stream closed: %v", err)
+				}
+				return
+			}
+
+			if event.Post == nil || event.Type != eventType {
+				continue
+			}
+
+			select {
+			case posts <- convertProtoPost(event.Post):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return posts, nil
+}
+
+// streamNotifications bridges the gRPC StreamNotifications stream of the
+// authenticated user to a GraphQL subscription channel.
+func (r *Resolver) streamNotifications(ctx context.Context) (<-chan *Notification, error) {
+	// Subscriptions are authenticated on connection_init
+	if _, err := auth.ExtractUserFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("authentication required: %v", err)
+	}
+
+	stream, err := r.notificationClient.StreamNotifications(ctx, &pb.StreamNotificationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make(chan *Notification, 1)
+	go func() {
+		defer close(notifications)
+
+		for {
+			notification, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Printf("Notification stream closed: %v", err)
+				}
+				return
+			}
+
+			select {
+			case notifications <- convertProtoNotification(notification):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return notifications, nil
+}
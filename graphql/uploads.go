@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	pb "muze/proto"
+)
+
+// uploadChunkSize is the size of the chunks uploads are streamed in
+const uploadChunkSize = 64 << 10
+
+// streamUpload sends a multipart upload to the gRPC UploadMedia stream
+func (r *Resolver) streamUpload(ctx context.Context, file graphql.Upload) (*pb.Media, error) {
+	stream, err := r.grpcClient.UploadMedia(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&pb.UploadMediaRequest{Data: &pb.UploadMediaRequest_Metadata{Metadata: &pb.MediaMetadata{
+		Filename:    file.Filename,
+		ContentType: file.ContentType,
+		Size:        file.Size,
+	}}})
+
+	buf := make([]byte, uploadChunkSize)
+	for err == nil {
+		var n int
+		n, err = io.ReadFull(file.File, buf)
+		if n > 0 {
+			chunk := make([]byte, n)
+			copy(chunk, buf[:n])
+			if sendErr := stream.Send(&pb.UploadMediaRequest{Data: &pb.UploadMediaRequest_Chunk{Chunk: chunk}}); sendErr != nil {
+				err = sendErr
+			}
+		}
+	}
+
+	// Send fails with io.EOF when the server rejected the upload early; the
+	// reason is reported by CloseAndRecv
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	return stream.CloseAndRecv()
+}
+
+// stringValue returns the value of an optional argument, or "" when unset
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -9,22 +9,59 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// contextKey is unexported so only this package can set the user claims
+type contextKey struct{}
+
+// userContextKey holds the authenticated *Claims in a context
+var userContextKey = contextKey{}
+
+// ErrMissingSecret is returned when JWT_SECRET is not configured
+var ErrMissingSecret = errors.New("JWT_SECRET is not configured")
+
+// Roles with elevated permissions
+const (
+	RoleAdmin     = "admin"
+	RoleModerator = "moderator"
+)
+
 type Claims struct {
 	UserID string `json:"user_id"`
 	Name   string `json:"name"`
+	Role   string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IsAdmin reports whether the claims carry the admin role
+func (c *Claims) IsAdmin() bool {
+	return c.Role == RoleAdmin
+}
+
+// IsModerator reports whether the claims may moderate content, which
+// admins can as well
+func (c *Claims) IsModerator() bool {
+	return c.Role == RoleModerator || c.IsAdmin()
+}
+
 // GenerateToken creates a new JWT token
 func GenerateToken(userID, name string) (string, error) {
 	return GenerateTokenWithExpiry(userID, name, time.Now().Add(24*time.Hour))
 }
 
+// GenerateTokenWithRole creates a new JWT token for a user with a role
+func GenerateTokenWithRole(userID, name, role string) (string, error) {
+	return generateToken(userID, name, role, time.Now().Add(24*time.Hour))
+}
+
 // GenerateTokenWithExpiry creates a new JWT token with custom expiry
 func GenerateTokenWithExpiry(userID, name string, expiry time.Time) (string, error) {
+	return generateToken(userID, name, "", expiry)
+}
+
+func generateToken(userID, name, role string, expiry time.Time) (string, error) {
 	claims := Claims{
 		UserID: userID,
 		Name:   name,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiry),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -32,21 +69,31 @@ func GenerateTokenWithExpiry(userID, name string, expiry time.Time) (string, err
 		},
 	}
 
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return "", ErrMissingSecret
+	}
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(os.Getenv("JWT_SECRET")))
+	return token.SignedString([]byte(secret))
 }
 
 // ValidateToken validates and extracts user info from JWT token
 func ValidateToken(tokenString string) (*Claims, error) {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return nil, ErrMissingSecret
+	}
+
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(os.Getenv("JWT_SECRET")), nil
-	})
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
+	if claims, ok := token.Claims.(*Claims); ok && token.Valid && claims.UserID != "" {
 		return claims, nil
 	}
 
@@ -55,7 +102,7 @@ func ValidateToken(tokenString string) (*Claims, error) {
 
 // ExtractUserFromContext extracts user info from context
 func ExtractUserFromContext(ctx context.Context) (*Claims, error) {
-	user := ctx.Value("user")
+	user := ctx.Value(userContextKey)
 	if user == nil {
 		return nil, errors.New("user not found in context")
 	}
@@ -67,3 +114,8 @@ func ExtractUserFromContext(ctx context.Context) (*Claims, error) {
 
 	return claims, nil
 }
+
+// ContextWithUser stores user claims in context
+func ContextWithUser(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, userContextKey, claims)
+}
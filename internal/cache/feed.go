@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"muze/internal/models"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// FeedWindowSize is the number of most recent posts kept in the feed window
+	FeedWindowSize = 50
+
+	feedKey      = "feed:recent"
+	feedTotalKey = "feed:total"
+	feedTTL      = 5 * time.Minute
+)
+
+// ErrFeedMiss is returned when the cached feed window cannot answer a page
+var ErrFeedMiss = errors.New("feed window does not cover the requested page")
+
+// addToFeedScript adds a post to the window and bumps the total, but only
+// while the window is warm, so a partial window is never mistaken for a
+// complete one. The window shares the total's expiry.
+var addToFeedScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[2]) == 1 then
+	redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+	redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -(tonumber(ARGV[3]) + 1))
+	redis.call("INCR", KEYS[2])
+	local ttl = redis.call("PTTL", KEYS[2])
+	if ttl > 0 then
+		redis.call("PEXPIRE", KEYS[1], ttl)
+	end
+end
+return 0
+`)
+
+// feedScore orders posts by created_at; equal scores fall back to member
+// order, which matches the (created_at DESC, id DESC) database ordering
+func feedScore(post models.Post) float64 {
+	return float64(post.CreatedAt.UnixMicro())
+}
+
+// CacheFeed replaces the feed window with the given newest-first posts and
+// records the total number of posts
+func CacheFeed(posts []models.Post, total int64) error {
+	ctx := context.Background()
+
+	if len(posts) > FeedWindowSize {
+		posts = posts[:FeedWindowSize]
+	}
+
+	pipe := RedisClient.TxPipeline()
+	pipe.Del(ctx, feedKey)
+	for _, post := range posts {
+		postJSON, err := json.Marshal(post)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, fmt.Sprintf("post:%s", post.ID), postJSON, 5*time.Minute)
+		pipe.ZAdd(ctx, feedKey, redis.Z{Score: feedScore(post), Member: post.ID})
+	}
+	pipe.Expire(ctx, feedKey, feedTTL)
+	pipe.Set(ctx, feedTotalKey, total, feedTTL)
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetFeedPage returns the post IDs for the page starting at offset along
+// with the total number of posts. A limit of zero asks for every post.
+// ErrFeedMiss is returned when the window does not hold the whole page.
+func GetFeedPage(offset, limit int) ([]string, int64, error) {
+	ctx := context.Background()
+
+	pipe := RedisClient.Pipeline()
+	cardCmd := pipe.ZCard(ctx, feedKey)
+	totalCmd := pipe.Get(ctx, feedTotalKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		if err == redis.Nil {
+			return nil, 0, ErrFeedMiss
+		}
+		return nil, 0, err
+	}
+
+	windowLen := cardCmd.Val()
+	total, err := strconv.ParseInt(totalCmd.Val(), 10, 64)
+	if err != nil || windowLen == 0 && total > 0 {
+		return nil, 0, ErrFeedMiss
+	}
+
+	// The window answers the page when it holds every post or the page
+	// ends inside the window
+	complete := windowLen >= total
+	if !complete && (limit <= 0 || int64(offset+limit) > windowLen) {
+		return nil, 0, ErrFeedMiss
+	}
+
+	stop := int64(-1)
+	if limit > 0 {
+		stop = int64(offset + limit - 1)
+	}
+	ids, err := RedisClient.ZRevRange(ctx, feedKey, int64(offset), stop).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ids, total, nil
+}
+
+// AddToFeed pushes a newly created post onto the feed window
+func AddToFeed(post models.Post) error {
+	ctx := context.Background()
+
+	return addToFeedScript.Run(ctx, RedisClient,
+		[]string{feedKey, feedTotalKey},
+		feedScore(post), post.ID, FeedWindowSize,
+	).Err()
+}
+
+// removeFromFeedScript drops a post from the window and the total. The
+// total is decremented even when the post has already left the window.
+var removeFromFeedScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[2]) == 1 then
+	redis.call("ZREM", KEYS[1], ARGV[1])
+	redis.call("DECR", KEYS[2])
+end
+return 0
+`)
+
+// RemoveFromFeed removes a deleted post from the feed window
+func RemoveFromFeed(postID string) error {
+	ctx := context.Background()
+
+	return removeFromFeedScript.Run(ctx, RedisClient,
+		[]string{feedKey, feedTotalKey},
+		postID,
+	).Err()
+}
+
+// InvalidateFeed drops the feed window so the next read rebuilds it
+func InvalidateFeed() error {
+	ctx := context.Background()
+
+	return RedisClient.Del(ctx, feedKey, feedTotalKey).Err()
+}
+
+// GetCachedPosts retrieves cached posts in batch, returning the posts found
+// by ID and the IDs that were not cached
+func GetCachedPosts(postIDs []string) (map[string]models.Post, []string, error) {
+	ctx := context.Background()
+
+	if len(postIDs) == 0 {
+		return map[string]models.Post{}, nil, nil
+	}
+
+	keys := make([]string, len(postIDs))
+	for i, id := range postIDs {
+		keys[i] = fmt.Sprintf("post:%s", id)
+	}
+
+	results, err := RedisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, postIDs, err
+	}
+
+	found := make(map[string]models.Post, len(postIDs))
+	var missing []string
+	for i, result := range results {
+		value, ok := result.(string)
+		if !ok {
+			missing = append(missing, postIDs[i])
+			continue
+		}
+
+		var post models.Post
+		if err := json.Unmarshal([]byte(value), &post); err != nil {
+			missing = append(missing, postIDs[i])
+			continue
+		}
+		found[postIDs[i]] = post
+	}
+
+	return found, missing, nil
+}
@@ -30,43 +30,6 @@ func InitRedis() {
 	log.Println("Redis connected successfully")
 }
 
-// CacheRecentPosts caches exactly 10 most recent posts with 5-minute TTL
-func CacheRecentPosts(posts []models.Post) error {
-	ctx := context.Background()
-
-	// Convert posts to JSON
-	postsJSON, err := json.Marshal(posts)
-	if err != nil {
-		return err
-	}
-
-	// Cache with 5-minute TTL as specified
-	err = RedisClient.Set(ctx, "recent_posts", postsJSON, 5*time.Minute).Err()
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// GetRecentPosts retrieves cached recent posts
-func GetRecentPosts() ([]models.Post, error) {
-	ctx := context.Background()
-
-	result, err := RedisClient.Get(ctx, "recent_posts").Result()
-	if err != nil {
-		return nil, err
-	}
-
-	var posts []models.Post
-	err = json.Unmarshal([]byte(result), &posts)
-	if err != nil {
-		return nil, err
-	}
-
-	return posts, nil
-}
-
 // CachePost caches individual post
 func CachePost(post models.Post) error {
 	ctx := context.Background()
@@ -108,17 +71,8 @@ func GetCachedPost(postID string) (*models.Post, error) {
 func InvalidatePostCache(postID string) error {
 	ctx := context.Background()
 
+	// The feed window only holds IDs, so it stays valid and rehydrates
+	// the post on the next read
 	key := fmt.Sprintf("post:%s", postID)
-	err := RedisClient.Del(ctx, key).Err()
-	if err != nil {
-		return err
-	}
-
-	// Also invalidate recent posts cache
-	err = RedisClient.Del(ctx, "recent_posts").Err()
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return RedisClient.Del(ctx, key).Err()
 }
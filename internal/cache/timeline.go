@@ -0,0 +1,246 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// TimelineMaxLength caps the number of post IDs kept per timeline
+	TimelineMaxLength = 800
+
+	timelineTTL      = 7 * 24 * time.Hour
+	largeAccountsKey = "timeline:large-accounts"
+)
+
+// ErrTimelineMiss is returned when a timeline cannot answer a page and the
+// caller must read from the database
+var ErrTimelineMiss = errors.New("timeline does not cover the requested page")
+
+// TimelineEntry is a post ID in a timeline with the post's creation time
+type TimelineEntry struct {
+	PostID    string
+	CreatedAt time.Time
+}
+
+func timelineKey(userID string) string {
+	return fmt.Sprintf("timeline:%s", userID)
+}
+
+// timelineReadyKey marks a timeline as built, so an empty timeline can be
+// told apart from one that was never built or has expired
+func timelineReadyKey(userID string) string {
+	return fmt.Sprintf("timeline:%s:ready", userID)
+}
+
+// pushToTimelineScript adds a post to a built timeline and trims it to the
+// cap. Timelines that are not built are left alone and get the post when
+// they are rebuilt. The timeline shares the ready marker's expiry.
+var pushToTimelineScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[2]) == 1 then
+	redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+	redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -(tonumber(ARGV[3]) + 1))
+	local ttl = redis.call("PTTL", KEYS[2])
+	if ttl > 0 then
+		redis.call("PEXPIRE", KEYS[1], ttl)
+	end
+end
+return 0
+`)
+
+// PushToTimelines adds a newly created post to the timelines of the given
+// followers in a single round trip
+func PushToTimelines(entry TimelineEntry, userIDs []string) error {
+	ctx := context.Background()
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	// Make sure the script is cached so the pipeline can use EVALSHA
+	if err := pushToTimelineScript.Load(ctx, RedisClient).Err(); err != nil {
+		return err
+	}
+
+	pipe := RedisClient.Pipeline()
+	for _, userID := range userIDs {
+		pushToTimelineScript.EvalSha(ctx, pipe,
+			[]string{timelineKey(userID), timelineReadyKey(userID)},
+			entry.CreatedAt.UnixMicro(), entry.PostID, TimelineMaxLength,
+		)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemoveFromTimelines removes a deleted post from the given timelines
+func RemoveFromTimelines(postID string, userIDs []string) error {
+	ctx := context.Background()
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	pipe := RedisClient.Pipeline()
+	for _, userID := range userIDs {
+		pipe.ZRem(ctx, timelineKey(userID), postID)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RebuildTimeline replaces a user's timeline with the given entries and
+// marks it as built
+func RebuildTimeline(userID string, entries []TimelineEntry) error {
+	ctx := context.Background()
+
+	if len(entries) > TimelineMaxLength {
+		entries = entries[:TimelineMaxLength]
+	}
+
+	pipe := RedisClient.TxPipeline()
+	pipe.Del(ctx, timelineKey(userID))
+	for _, entry := range entries {
+		pipe.ZAdd(ctx, timelineKey(userID), redis.Z{
+			Score:  float64(entry.CreatedAt.UnixMicro()),
+			Member: entry.PostID,
+		})
+	}
+	pipe.Expire(ctx, timelineKey(userID), timelineTTL)
+	pipe.Set(ctx, timelineReadyKey(userID), 1, timelineTTL)
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// InvalidateTimeline drops a user's timeline so the next read rebuilds it
+func InvalidateTimeline(userID string) error {
+	ctx := context.Background()
+
+	return RedisClient.Del(ctx, timelineKey(userID), timelineReadyKey(userID)).Err()
+}
+
+// InvalidateTimelines drops the timelines of the given users in a single
+// round trip
+func InvalidateTimelines(userIDs []string) error {
+	ctx := context.Background()
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, 2*len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, timelineKey(userID), timelineReadyKey(userID))
+	}
+	return RedisClient.Del(ctx, keys...).Err()
+}
+
+// GetTimelinePage returns up to limit entries older than the (createdAt, id)
+// position, newest first. A zero createdAt starts at the newest post.
+// ErrTimelineMiss is returned when the timeline is not built, or when the
+// page runs past the oldest entry of a timeline that has been trimmed.
+func GetTimelinePage(userID string, createdAt time.Time, id string, limit int) ([]TimelineEntry, error) {
+	ctx := context.Background()
+
+	key := timelineKey(userID)
+	max := "+inf"
+	if !createdAt.IsZero() {
+		max = "(" + strconv.FormatInt(createdAt.UnixMicro(), 10)
+	}
+
+	pipe := RedisClient.Pipeline()
+	readyCmd := pipe.Exists(ctx, timelineReadyKey(userID))
+	cardCmd := pipe.ZCard(ctx, key)
+	// Posts created in the cursor's microsecond are ordered by ID, like
+	// the (created_at DESC, id DESC) database ordering
+	var tiesCmd *redis.StringSliceCmd
+	if !createdAt.IsZero() {
+		score := strconv.FormatInt(createdAt.UnixMicro(), 10)
+		tiesCmd = pipe.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{Max: score, Min: score})
+	}
+	olderCmd := pipe.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Max:   max,
+		Min:   "-inf",
+		Count: int64(limit),
+	})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	if readyCmd.Val() == 0 {
+		return nil, ErrTimelineMiss
+	}
+
+	entries := make([]TimelineEntry, 0, limit)
+	if tiesCmd != nil {
+		for _, postID := range tiesCmd.Val() {
+			if postID < id {
+				entries = append(entries, TimelineEntry{PostID: postID, CreatedAt: createdAt})
+			}
+		}
+	}
+	for _, z := range olderCmd.Val() {
+		entries = append(entries, TimelineEntry{
+			PostID:    z.Member.(string),
+			CreatedAt: time.UnixMicro(int64(z.Score)).UTC(),
+		})
+	}
+	if len(entries) >= limit {
+		return entries[:limit], nil
+	}
+
+	// A short page from a trimmed timeline may be missing older posts
+	if cardCmd.Val() >= TimelineMaxLength {
+		return nil, ErrTimelineMiss
+	}
+	return entries, nil
+}
+
+// MarkLargeAccount records that an author's posts are merged into timelines
+// on read instead of being pushed to every follower
+func MarkLargeAccount(userID string) error {
+	ctx := context.Background()
+
+	return RedisClient.SAdd(ctx, largeAccountsKey, userID).Err()
+}
+
+// UnmarkLargeAccount returns an author to fan-out-on-write. It reports
+// whether the author was marked, so only one caller acts on the change.
+func UnmarkLargeAccount(userID string) (bool, error) {
+	ctx := context.Background()
+
+	removed, err := RedisClient.SRem(ctx, largeAccountsKey, userID).Result()
+	return removed > 0, err
+}
+
+// FilterLargeAccounts returns the given users that are read on demand
+func FilterLargeAccounts(userIDs []string) ([]string, error) {
+	ctx := context.Background()
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	members := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		members[i] = id
+	}
+
+	flags, err := RedisClient.SMIsMember(ctx, largeAccountsKey, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var large []string
+	for i, isLarge := range flags {
+		if isLarge {
+			large = append(large, userIDs[i])
+		}
+	}
+	return large, nil
+}
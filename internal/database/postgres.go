@@ -24,24 +24,89 @@ func InitDB() {
 
 	var err error
 	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true,
 	})
 
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
 
+	// Drop duplicate likes left by the old check-then-insert race so the
+	// unique (post_id, user_id) index can be created, then resync counters
+	if DB.Migrator().HasTable(&models.PostLike{}) && !DB.Migrator().HasIndex(&models.PostLike{}, "idx_post_likes_post_user") {
+		DB.Exec(`DELETE FROM post_likes a USING post_likes b
+			WHERE a.post_id = b.post_id AND a.user_id = b.user_id AND a.id > b.id`)
+		DB.Exec(`UPDATE posts SET likes = (SELECT COUNT(*) FROM post_likes WHERE post_likes.post_id = posts.id::text)`)
+	}
+
 	// Auto migrate tables
-	err = DB.AutoMigrate(&models.Post{}, &models.User{}, &models.PostLike{})
+	err = DB.AutoMigrate(&models.Post{}, &models.User{}, &models.PostReaction{}, &models.Comment{}, &models.Follow{}, &models.PostTag{}, &models.PostMention{},
+		&models.Notification{}, &models.NotificationActor{}, &models.Media{}, &models.PostMedia{}, &models.Bookmark{},
+		&models.Report{}, &models.ModerationAction{}, &models.Suspension{})
 	if err != nil {
 		log.Fatal("Failed to migrate database:", err)
 	}
 
+	// Likes become "like" reactions; post_likes is dropped once copied
+	if DB.Migrator().HasTable(&models.PostLike{}) {
+		err = DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(`INSERT INTO post_reactions (post_id, user_id, type, created_at)
+				SELECT post_id::uuid, user_id, 'like', NOW() FROM post_likes
+				ON CONFLICT DO NOTHING`).Error; err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&models.PostLike{})
+		})
+		if err != nil {
+			log.Fatal("Failed to migrate likes to reactions:", err)
+		}
+	}
+	DB.Exec(`UPDATE posts SET reaction_counts = (
+			SELECT jsonb_object_agg(type, n) FROM (
+				SELECT type, COUNT(*) AS n FROM post_reactions
+				WHERE post_reactions.post_id = posts.id GROUP BY type) counts)
+		WHERE reaction_counts IS NULL
+			AND EXISTS (SELECT 1 FROM post_reactions WHERE post_reactions.post_id = posts.id)`)
+
 	// Create indexes for performance optimization
 	DB.Exec("CREATE INDEX IF NOT EXISTS idx_posts_author_id ON posts(author_id)")
 	DB.Exec("CREATE INDEX IF NOT EXISTS idx_posts_created_at ON posts(created_at DESC)")
-	DB.Exec("CREATE INDEX IF NOT EXISTS idx_post_likes_post_id ON post_likes(post_id)")
-	DB.Exec("CREATE INDEX IF NOT EXISTS idx_post_likes_user_id ON post_likes(user_id)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_posts_created_at_id ON posts(created_at DESC, id DESC)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_post_reactions_user_id ON post_reactions(user_id)")
+	DB.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_posts_author_repost_of ON posts(author_id, repost_of_id)
+		WHERE repost_of_id IS NOT NULL AND deleted_at IS NULL`)
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_posts_parent_created_at_id ON posts(parent_id, created_at, id) WHERE parent_id IS NOT NULL")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_bookmarks_user_created_at_id ON bookmarks(user_id, created_at, id)")
+	DB.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_post_reporter_open ON reports(post_id, reporter_id)
+		WHERE status = 'open'`)
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_reports_status_created_at_id ON reports(status, created_at, id)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_moderation_actions_created_at_id ON moderation_actions(created_at, id)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_comments_post_created_at_id ON comments(post_id, created_at, id)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_follows_followee_id ON follows(followee_id)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_post_tags_tag ON post_tags(tag)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_post_mentions_user_id ON post_mentions(user_id)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_notifications_user_updated_at_id ON notifications(user_id, updated_at DESC, id DESC)")
+	DB.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_unread_group ON notifications(user_id, type, group_key) WHERE read_at IS NULL")
+
+	// Full-text search over post content
+	DB.Exec(`ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (to_tsvector('english', content)) STORED`)
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING GIN(search_vector)")
+	DB.Exec("CREATE INDEX IF NOT EXISTS idx_posts_author_created_at_id ON posts(author_id, created_at DESC, id DESC)")
+
+	// Posts from before attachments keep their image as the first one
+	DB.Exec(`INSERT INTO post_media (post_id, position, type, url)
+		SELECT id, 0, 'image', image_url FROM posts
+		WHERE image_url IS NOT NULL AND image_url <> ''
+			AND NOT EXISTS (SELECT 1 FROM post_media WHERE post_media.post_id = posts.id)`)
+	DB.Exec(`UPDATE posts SET media = (
+			SELECT jsonb_agg(jsonb_build_object(
+				'id', m.id, 'post_id', m.post_id, 'position', m.position, 'media_id', m.media_id,
+				'type', m.type, 'url', m.url, 'thumbnail_url', m.thumbnail_url, 'width', m.width,
+				'height', m.height, 'alt', m.alt, 'blurhash', m.blurhash) ORDER BY m.position)
+			FROM post_media m WHERE m.post_id = posts.id)
+		WHERE media IS NULL AND image_url IS NOT NULL AND image_url <> ''`)
 
 	log.Println("Database connected and migrated successfully")
 }
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"muze/internal/auth"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryAuthInterceptor validates the bearer token in the request metadata,
+// when present, and puts its claims into the handler context. Handlers
+// decide which calls require an authenticated caller.
+func UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor is the streaming counterpart of UnaryAuthInterceptor
+func StreamAuthInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticatedStream overrides the stream context with the caller's claims
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate reads the authorization metadata and returns a context
+// carrying the caller's claims. Calls without a token pass through.
+func authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 || values[0] == "" {
+		return ctx, nil
+	}
+
+	if !strings.HasPrefix(values[0], "Bearer ") {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization metadata must use the Bearer scheme")
+	}
+
+	claims, err := auth.ValidateToken(strings.TrimPrefix(values[0], "Bearer "))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token")
+	}
+
+	return auth.ContextWithUser(ctx, claims), nil
+}
+
+// requireUser returns the authenticated caller or an Unauthenticated error
+func requireUser(ctx context.Context) (*auth.Claims, error) {
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+	return claims, nil
+}
+
+// requireModerator returns the caller's claims if they may moderate content
+func requireModerator(ctx context.Context) (*auth.Claims, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.IsModerator() {
+		return nil, status.Errorf(codes.PermissionDenied, "moderator role required")
+	}
+	return claims, nil
+}
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"muze/internal/auth"
+	"muze/internal/models"
+	pb "muze/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// bookmarkedPost is a post in the caller's bookmarks with the bookmark's
+// keyset position
+type bookmarkedPost struct {
+	models.Post  `gorm:"embedded"`
+	BookmarkID   string
+	BookmarkedAt time.Time
+}
+
+// Bookmark saves a post for the caller. Bookmarks are private, so unlike
+// other post activity nothing is published.
+func (s *PostServer) Bookmark(ctx context.Context, req *pb.BookmarkRequest) (*pb.Post, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := findSharedPost(s.db, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unique (user_id, post_id) index rejects a second bookmark even
+	// when two requests race
+	bookmark := models.Bookmark{UserID: claims.UserID, PostID: post.ID}
+	if err := s.db.Create(&bookmark).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, status.Errorf(codes.AlreadyExists, "post already bookmarked")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to bookmark post: %v", err)
+	}
+
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+// Unbookmark removes a post from the caller's bookmarks. Removing a post
+// that is not bookmarked is a no-op.
+func (s *PostServer) Unbookmark(ctx context.Context, req *pb.BookmarkRequest) (*pb.Post, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := findSharedPost(s.db, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Where("user_id = ? AND post_id = ?", claims.UserID, post.ID).Delete(&models.Bookmark{}).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove bookmark: %v", err)
+	}
+
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+// ListBookmarks pages the caller's bookmarked posts, most recently
+// bookmarked first. Bookmarks of deleted and hidden posts are left out.
+func (s *PostServer) ListBookmarks(ctx context.Context, req *pb.ListBookmarksRequest) (*pb.GetPostsResponse, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(req.First)
+	query := s.db.Model(&models.Post{}).
+		Select("posts.*, bookmarks.id AS bookmark_id, bookmarks.created_at AS bookmarked_at").
+		Joins("JOIN bookmarks ON bookmarks.post_id = posts.id").
+		Where("bookmarks.user_id = ?", claims.UserID).
+		Where("posts.deleted_at IS NULL").
+		Scopes(visiblePosts)
+	if req.After != "" {
+		createdAt, id, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(bookmarks.created_at, bookmarks.id) < (?, ?)", createdAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var results []bookmarkedPost
+	err = query.Order("bookmarks.created_at DESC, bookmarks.id DESC").Limit(limit + 1).Scan(&results).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get bookmarks: %v", err)
+	}
+
+	hasNextPage := len(results) > limit
+	if hasNextPage {
+		results = results[:limit]
+	}
+
+	edges := make([]*pb.PostEdge, 0, len(results))
+	for _, result := range results {
+		edges = append(edges, &pb.PostEdge{
+			Post:   s.convertToProtoPost(result.Post),
+			Cursor: encodeCursor(result.BookmarkedAt, result.BookmarkID),
+		})
+	}
+
+	pageInfo := buildPageInfo(edges, req.After != "", hasNextPage)
+
+	return s.expandPage(ctx, &pb.GetPostsResponse{Edges: edges, PageInfo: pageInfo}), nil
+}
+
+// withViewerBookmarks fills in viewer_has_bookmarked for the authenticated
+// caller. Only the caller's own bookmarks are ever read.
+func (s *PostServer) withViewerBookmarks(ctx context.Context, posts ...*pb.Post) {
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil || len(posts) == 0 {
+		return
+	}
+
+	postIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.Id)
+	}
+
+	var bookmarked []string
+	err = s.db.Model(&models.Bookmark{}).
+		Where("user_id = ? AND post_id IN ?", claims.UserID, postIDs).
+		Pluck("post_id", &bookmarked).Error
+	if err != nil {
+		log.Printf("Failed to load viewer bookmarks: %v", err)
+		return
+	}
+
+	saved := make(map[string]bool, len(bookmarked))
+	for _, id := range bookmarked {
+		saved[id] = true
+	}
+	for _, post := range posts {
+		post.ViewerHasBookmarked = saved[post.Id]
+	}
+}
@@ -0,0 +1,253 @@
+package grpc
+
+import (
+	"context"
+	"muze/internal/cache"
+	"muze/internal/messaging"
+	"muze/internal/models"
+	pb "muze/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// maxCommentBatch bounds ListCommentsByPosts requests
+const maxCommentBatch = 100
+
+// Helper function to convert Go model to protobuf Comment
+func convertToProtoComment(comment models.Comment) *pb.Comment {
+	return &pb.Comment{
+		Id:         comment.ID,
+		PostId:     comment.PostID,
+		AuthorId:   comment.AuthorID,
+		AuthorName: comment.AuthorName,
+		Content:    comment.Content,
+		Timestamp:  comment.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (s *PostServer) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.Comment, error) {
+	// Comments are always written as the authenticated caller
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if req.Content == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content cannot be empty")
+	}
+	if !isUUID(req.PostId) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+	if err := s.checkNotSuspended(claims.UserID); err != nil {
+		return nil, err
+	}
+
+	comment := models.Comment{
+		PostID:     req.PostId,
+		AuthorID:   claims.UserID,
+		AuthorName: s.authorName(claims),
+		Content:    req.Content,
+		CreatedAt:  time.Now().Truncate(time.Microsecond),
+	}
+
+	var post models.Post
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// Deleted and hidden posts cannot be commented on
+		if err := tx.Scopes(visiblePosts).Where("id = ?", req.PostId).First(&post).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Errorf(codes.NotFound, "post not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get post: %v", err)
+		}
+
+		if err := tx.Create(&comment).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to create comment: %v", err)
+		}
+
+		err := tx.Model(&post).UpdateColumn("comment_count", gorm.Expr("comment_count + 1")).Error
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update post: %v", err)
+		}
+
+		// Reload the counter written by the database
+		return tx.Where("id = ?", req.PostId).First(&post).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+
+	// Invalidate cache
+	cache.InvalidatePostCache(post.ID)
+
+	// Publish to NATS for real-time updates
+	messaging.PublishPostCommented(post, comment)
+
+	return convertToProtoComment(comment), nil
+}
+
+// ListComments returns a post's comments oldest first using keyset
+// pagination on (created_at, id)
+func (s *PostServer) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	if !isUUID(req.PostId) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
+	var post models.Post
+	err := s.db.Scopes(visiblePosts).Select("id").Where("id = ?", req.PostId).First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+
+	limit := pageSize(req.First)
+	query := s.db.Where("post_id = ?", req.PostId)
+	if req.After != "" {
+		createdAt, id, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(created_at, id) > (?, ?)", createdAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var comments []models.Comment
+	err = query.Order("created_at ASC, id ASC").Limit(limit + 1).Find(&comments).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get comments: %v", err)
+	}
+
+	return commentPage(comments, limit, req.After != ""), nil
+}
+
+// ListCommentsByPosts returns the first page of comments of each visible
+// post, so clients listing posts don't ask for their comments one by one
+func (s *PostServer) ListCommentsByPosts(ctx context.Context, req *pb.ListCommentsByPostsRequest) (*pb.ListCommentsByPostsResponse, error) {
+	if len(req.PostIds) > maxCommentBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d post ids can be requested", maxCommentBatch)
+	}
+
+	var ids []string
+	for _, id := range req.PostIds {
+		if isUUID(id) {
+			ids = append(ids, id)
+		}
+	}
+	resp := &pb.ListCommentsByPostsResponse{Pages: make(map[string]*pb.ListCommentsResponse)}
+	if len(ids) == 0 {
+		return resp, nil
+	}
+
+	var visible []string
+	if err := s.db.Model(&models.Post{}).Scopes(visiblePosts).Where("id IN ?", ids).Pluck("id", &visible).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get posts: %v", err)
+	}
+	if len(visible) == 0 {
+		return resp, nil
+	}
+
+	// Number each post's comments oldest first and keep one more than a
+	// page of each to learn whether another page exists
+	limit := pageSize(req.First)
+	ranked := s.db.Model(&models.Comment{}).
+		Select("*, ROW_NUMBER() OVER (PARTITION BY post_id ORDER BY created_at ASC, id ASC) AS position").
+		Where("post_id IN ?", visible)
+	var comments []models.Comment
+	err := s.db.Unscoped().Table("(?) AS ranked", ranked).
+		Where("position <= ?", limit+1).
+		Order("post_id, created_at ASC, id ASC").
+		Find(&comments).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get comments: %v", err)
+	}
+
+	byPost := make(map[string][]models.Comment)
+	for _, comment := range comments {
+		byPost[comment.PostID] = append(byPost[comment.PostID], comment)
+	}
+	for _, id := range visible {
+		resp.Pages[id] = commentPage(byPost[id], limit, false)
+	}
+	return resp, nil
+}
+
+// commentPage builds a page from comments fetched with one extra row
+func commentPage(comments []models.Comment, limit int, hasPreviousPage bool) *pb.ListCommentsResponse {
+	hasNextPage := len(comments) > limit
+	if hasNextPage {
+		comments = comments[:limit]
+	}
+
+	edges := make([]*pb.CommentEdge, 0, len(comments))
+	for _, comment := range comments {
+		edges = append(edges, &pb.CommentEdge{
+			Comment: convertToProtoComment(comment),
+			Cursor:  encodeCursor(comment.CreatedAt, comment.ID),
+		})
+	}
+
+	pageInfo := buildPageInfo(edges, hasPreviousPage, hasNextPage)
+
+	return &pb.ListCommentsResponse{Edges: edges, PageInfo: pageInfo}
+}
+
+func (s *PostServer) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment models.Comment
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", req.Id).First(&comment).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Errorf(codes.NotFound, "comment not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get comment: %v", err)
+		}
+
+		// Comment authors, the post's author and admins can delete a comment
+		if comment.AuthorID != claims.UserID && !claims.IsAdmin() {
+			var post models.Post
+			err := tx.Unscoped().Select("author_id").Where("id = ?", comment.PostID).First(&post).Error
+			if err != nil && err != gorm.ErrRecordNotFound {
+				return status.Errorf(codes.Internal, "failed to get post: %v", err)
+			}
+			if post.AuthorID != claims.UserID {
+				return status.Errorf(codes.PermissionDenied, "only the comment author, the post author or an admin can delete this comment")
+			}
+		}
+
+		// Soft delete through gorm's DeletedAt column
+		if err := tx.Delete(&comment).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+		}
+
+		err := tx.Model(&models.Post{}).
+			Where("id = ? AND comment_count > 0", comment.PostID).
+			UpdateColumn("comment_count", gorm.Expr("comment_count - 1")).Error
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update post: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+	}
+
+	// Invalidate cache
+	cache.InvalidatePostCache(comment.PostID)
+
+	return &pb.DeleteCommentResponse{Id: comment.ID}, nil
+}
@@ -0,0 +1,236 @@
+package grpc
+
+import (
+	"context"
+	"muze/internal/messaging"
+	"muze/internal/models"
+	pb "muze/proto"
+	"strings"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// maxEntityLength bounds the text of a hashtag or mention in runes
+const maxEntityLength = 64
+
+// extractEntities finds the #hashtags and @mentions in content. A # or @
+// starts an entity when it does not follow a letter, digit or underscore.
+// Hashtags are letters, digits and underscores with at least one letter;
+// mentions also allow hyphens so user IDs can be mentioned. Words longer
+// than maxEntityLength are not entities. Mentions are returned unresolved.
+func extractEntities(content string) []models.PostEntity {
+	runes := []rune(content)
+
+	var entities []models.PostEntity
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '#' && runes[i] != '@' {
+			continue
+		}
+		if i > 0 && isEntityRune(runes[i-1]) {
+			continue
+		}
+
+		mention := runes[i] == '@'
+		end := i + 1
+		for end < len(runes) && (isEntityRune(runes[end]) || mention && runes[end] == '-') {
+			end++
+		}
+		// A trailing hyphen is punctuation, not part of the mention
+		for mention && end > i+1 && runes[end-1] == '-' {
+			end--
+		}
+
+		text := string(runes[i+1 : end])
+		if text == "" || !mention && strings.IndexFunc(text, unicode.IsLetter) < 0 {
+			continue
+		}
+		if end-i-1 > maxEntityLength {
+			i = end - 1
+			continue
+		}
+
+		entityType := models.EntityHashtag
+		if mention {
+			entityType = models.EntityMention
+		}
+		entities = append(entities, models.PostEntity{
+			Type:  entityType,
+			Text:  text,
+			Start: i,
+			End:   end,
+		})
+		i = end - 1
+	}
+
+	return entities
+}
+
+func isEntityRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// normalizeTag returns the form hashtags are stored and looked up in
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(tag, "#"))
+}
+
+// resolveEntities extracts the entities of content and resolves mentions
+// to users, by ID or by case-insensitive name. Mentions of unknown users
+// are dropped; a name shared by several users resolves to the oldest.
+func (s *PostServer) resolveEntities(content string) ([]models.PostEntity, error) {
+	entities := extractEntities(content)
+
+	var ids, names []string
+	for _, entity := range entities {
+		if entity.Type != models.EntityMention {
+			continue
+		}
+		if isUUID(entity.Text) {
+			ids = append(ids, strings.ToLower(entity.Text))
+		} else {
+			names = append(names, strings.ToLower(entity.Text))
+		}
+	}
+	if len(ids) == 0 && len(names) == 0 {
+		return entities, nil
+	}
+
+	var users []models.User
+	query := s.db.Select("id", "name")
+	switch {
+	case len(ids) > 0 && len(names) > 0:
+		query = query.Where("id IN ? OR lower(name) IN ?", ids, names)
+	case len(ids) > 0:
+		query = query.Where("id IN ?", ids)
+	default:
+		query = query.Where("lower(name) IN ?", names)
+	}
+	if err := query.Order("created_at ASC").Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	byText := make(map[string]string, len(users))
+	for _, user := range users {
+		byText[user.ID] = user.ID
+		if _, ok := byText[strings.ToLower(user.Name)]; !ok {
+			byText[strings.ToLower(user.Name)] = user.ID
+		}
+	}
+
+	resolved := entities[:0]
+	for _, entity := range entities {
+		if entity.Type == models.EntityMention {
+			userID, ok := byText[strings.ToLower(entity.Text)]
+			if !ok {
+				continue
+			}
+			entity.UserID = userID
+		}
+		resolved = append(resolved, entity)
+	}
+	return resolved, nil
+}
+
+// saveEntities replaces the post_tags and post_mentions rows of a post and
+// returns the users that were not mentioned before
+func saveEntities(tx *gorm.DB, postID string, entities []models.PostEntity) ([]string, error) {
+	var previous []string
+	if err := tx.Model(&models.PostMention{}).Where("post_id = ?", postID).Pluck("user_id", &previous).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Where("post_id = ?", postID).Delete(&models.PostTag{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Where("post_id = ?", postID).Delete(&models.PostMention{}).Error; err != nil {
+		return nil, err
+	}
+
+	wasMentioned := toSet(previous)
+	seen := map[string]bool{}
+	var tags []models.PostTag
+	var mentions []models.PostMention
+	var newlyMentioned []string
+	for _, entity := range entities {
+		switch entity.Type {
+		case models.EntityHashtag:
+			tag := normalizeTag(entity.Text)
+			if !seen["#"+tag] {
+				seen["#"+tag] = true
+				tags = append(tags, models.PostTag{PostID: postID, Tag: tag})
+			}
+		case models.EntityMention:
+			if !seen["@"+entity.UserID] {
+				seen["@"+entity.UserID] = true
+				mentions = append(mentions, models.PostMention{PostID: postID, UserID: entity.UserID})
+				if !wasMentioned[entity.UserID] {
+					newlyMentioned = append(newlyMentioned, entity.UserID)
+				}
+			}
+		}
+	}
+
+	if len(tags) > 0 {
+		if err := tx.Create(&tags).Error; err != nil {
+			return nil, err
+		}
+	}
+	if len(mentions) > 0 {
+		if err := tx.Create(&mentions).Error; err != nil {
+			return nil, err
+		}
+	}
+	return newlyMentioned, nil
+}
+
+// publishMentions notifies newly mentioned users, except the author
+func publishMentions(post models.Post, userIDs []string) {
+	for _, userID := range userIDs {
+		if userID != post.AuthorID {
+			messaging.PublishPostMentioned(post, userID)
+		}
+	}
+}
+
+// convertToProtoEntities converts stored post entities to protobuf
+func convertToProtoEntities(entities []models.PostEntity) []*pb.PostEntity {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	result := make([]*pb.PostEntity, 0, len(entities))
+	for _, entity := range entities {
+		entityType := pb.PostEntityType_POST_ENTITY_TYPE_HASHTAG
+		if entity.Type == models.EntityMention {
+			entityType = pb.PostEntityType_POST_ENTITY_TYPE_MENTION
+		}
+		result = append(result, &pb.PostEntity{
+			Type:   entityType,
+			Text:   entity.Text,
+			Start:  int32(entity.Start),
+			End:    int32(entity.End),
+			UserId: entity.UserID,
+		})
+	}
+	return result
+}
+
+// PostsByHashtag returns the posts tagged with a hashtag, newest first
+func (s *PostServer) PostsByHashtag(ctx context.Context, req *pb.PostsByHashtagRequest) (*pb.GetPostsResponse, error) {
+	// Validate input
+	tag := normalizeTag(strings.TrimSpace(req.Tag))
+	if tag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag cannot be empty")
+	}
+
+	tagged := s.db.Model(&models.PostTag{}).Select("post_id").Where("tag = ?", tag)
+	query := s.db.Model(&models.Post{}).Scopes(visiblePosts).Where("id IN (?)", tagged)
+
+	resp, err := s.queryPostsPage(query, req.After, pageSize(req.First))
+	if err != nil {
+		return nil, err
+	}
+	return s.expandPage(ctx, resp), nil
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"log"
+	"muze/internal/cache"
+	"muze/internal/models"
+)
+
+// getFeedFromCache serves an offset page from the cached feed window,
+// warming the window when it is cold and the page could fit in it.
+// ok is false when the caller must fall back to the database.
+func (s *PostServer) getFeedFromCache(offset, limit int) (posts []models.Post, total int64, ok bool) {
+	ids, total, err := cache.GetFeedPage(offset, limit)
+	if err == cache.ErrFeedMiss && limit > 0 && offset+limit <= cache.FeedWindowSize {
+		if s.warmFeedCache() {
+			ids, total, err = cache.GetFeedPage(offset, limit)
+		}
+	}
+	if err != nil {
+		return nil, 0, false
+	}
+
+	posts, err = s.loadPosts(ids)
+	if err != nil || len(posts) != len(ids) {
+		// The window references posts that no longer exist
+		cache.InvalidateFeed()
+		return nil, 0, false
+	}
+
+	return posts, total, true
+}
+
+// warmFeedCache loads the most recent posts into the feed window
+func (s *PostServer) warmFeedCache() bool {
+	var total int64
+	if err := s.db.Model(&models.Post{}).Scopes(visiblePosts).Count(&total).Error; err != nil {
+		return false
+	}
+
+	var posts []models.Post
+	err := s.db.Scopes(visiblePosts).Order("created_at DESC, id DESC").Limit(cache.FeedWindowSize).Find(&posts).Error
+	if err != nil {
+		return false
+	}
+
+	if err := cache.CacheFeed(posts, total); err != nil {
+		log.Printf("Failed to cache feed: %v", err)
+		return false
+	}
+	return true
+}
+
+// loadPosts hydrates posts by ID in the given order, reading through the
+// per-post cache and batching misses into a single query. IDs that no
+// longer exist are skipped.
+func (s *PostServer) loadPosts(ids []string) ([]models.Post, error) {
+	found, missing, err := cache.GetCachedPosts(ids)
+	if err != nil {
+		found = map[string]models.Post{}
+		missing = ids
+	}
+
+	if len(missing) > 0 {
+		var posts []models.Post
+		if err := s.db.Scopes(visiblePosts).Where("id IN ?", missing).Find(&posts).Error; err != nil {
+			return nil, err
+		}
+		for _, post := range posts {
+			cache.CachePost(post)
+			found[post.ID] = post
+		}
+	}
+
+	posts := make([]models.Post, 0, len(ids))
+	for _, id := range ids {
+		if post, ok := found[id]; ok {
+			posts = append(posts, post)
+		}
+	}
+	return posts, nil
+}
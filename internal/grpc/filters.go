@@ -0,0 +1,293 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"muze/internal/models"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Built-in filter settings used when their variables are unset
+const (
+	defaultMaxPostLength = 5000
+	defaultMaxPostLinks  = 5
+)
+
+// filterReporterID is the reporter of the reports that flag posts for review
+const filterReporterID = "content-filter"
+
+// filterErrorDomain is the ErrorInfo domain of filter rejections
+const filterErrorDomain = "muze.post_filter"
+
+// FilterVerdict is what a filter decides about a post
+type FilterVerdict int
+
+const (
+	// FilterAllow lets the post through
+	FilterAllow FilterVerdict = iota
+	// FilterFlag saves the post and queues it for moderators
+	FilterFlag
+	// FilterReject refuses the post with InvalidArgument
+	FilterReject
+)
+
+// FilterResult is a filter's verdict on a post. The zero value allows it.
+// Field names the request field a rejection is about, "content" when
+// empty, and Reason is shown to the author or to moderators.
+type FilterResult struct {
+	Verdict FilterVerdict
+	Field   string
+	Reason  string
+}
+
+// PostCandidate is a post about to be created, or an edit of the post
+// with PostID
+type PostCandidate struct {
+	PostID       string
+	AuthorID     string
+	Content      string
+	ParentID     string
+	QuotedPostID string
+}
+
+// PostFilter checks posts before they are written. Name identifies the
+// filter in rejections and flags. An error fails the request instead of
+// deciding about the post.
+type PostFilter interface {
+	Name() string
+	Check(ctx context.Context, post PostCandidate) (FilterResult, error)
+}
+
+// violation is the result of a built-in filter that found a problem, which
+// flags instead of rejecting when the filter is configured to
+func violation(flag bool, reason string) FilterResult {
+	if flag {
+		return FilterResult{Verdict: FilterFlag, Reason: reason}
+	}
+	return FilterResult{Verdict: FilterReject, Reason: reason}
+}
+
+// MaxLengthFilter limits the number of characters in a post
+type MaxLengthFilter struct {
+	MaxLength int
+	Flag      bool
+}
+
+func (f MaxLengthFilter) Name() string { return "max_length" }
+
+func (f MaxLengthFilter) Check(ctx context.Context, post PostCandidate) (FilterResult, error) {
+	if length := utf8.RuneCountInString(post.Content); length > f.MaxLength {
+		return violation(f.Flag, fmt.Sprintf("content is %d characters, more than %d", length, f.MaxLength)), nil
+	}
+	return FilterResult{}, nil
+}
+
+// BannedWordsFilter matches whole words case-insensitively
+type BannedWordsFilter struct {
+	Words []string
+	Flag  bool
+}
+
+func (f BannedWordsFilter) Name() string { return "banned_words" }
+
+func (f BannedWordsFilter) Check(ctx context.Context, post PostCandidate) (FilterResult, error) {
+	words := make(map[string]bool)
+	for _, word := range contentWords(post.Content) {
+		words[word] = true
+	}
+	for _, banned := range f.Words {
+		if words[strings.ToLower(banned)] {
+			return violation(f.Flag, fmt.Sprintf("content contains banned word %q", banned)), nil
+		}
+	}
+	return FilterResult{}, nil
+}
+
+// contentWords splits content into lowercase words
+func contentWords(content string) []string {
+	return strings.FieldsFunc(strings.ToLower(content), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// LinkLimitFilter limits the number of links in a post
+type LinkLimitFilter struct {
+	MaxLinks int
+	Flag     bool
+}
+
+func (f LinkLimitFilter) Name() string { return "links" }
+
+func (f LinkLimitFilter) Check(ctx context.Context, post PostCandidate) (FilterResult, error) {
+	if links := len(linkPattern.FindAllString(post.Content, -1)); links > f.MaxLinks {
+		return violation(f.Flag, fmt.Sprintf("content has %d links, more than %d", links, f.MaxLinks)), nil
+	}
+	return FilterResult{}, nil
+}
+
+// DuplicateFilter catches an author posting the same content again within
+// Window. Content is compared ignoring case and whitespace, normalized by
+// Postgres on both sides; reposts, deleted posts, the post being edited and
+// posts with other authors never match. The check runs before the post is
+// written, so identical posts sent at the same moment can both pass.
+type DuplicateFilter struct {
+	DB     *gorm.DB
+	Window time.Duration
+	Flag   bool
+}
+
+func (f DuplicateFilter) Name() string { return "duplicate" }
+
+func (f DuplicateFilter) Check(ctx context.Context, post PostCandidate) (FilterResult, error) {
+	query := f.DB.WithContext(ctx).Model(&models.Post{}).
+		Where("author_id = ? AND repost_of_id IS NULL AND created_at > ?", post.AuthorID, time.Now().Add(-f.Window)).
+		Where(normalizedContent("content")+" = "+normalizedContent("?"), post.Content)
+	if post.PostID != "" {
+		query = query.Where("id <> ?", post.PostID)
+	}
+
+	var duplicates int64
+	if err := query.Count(&duplicates).Error; err != nil {
+		return FilterResult{}, err
+	}
+	if duplicates > 0 {
+		return violation(f.Flag, "you already posted this"), nil
+	}
+	return FilterResult{}, nil
+}
+
+// normalizedContent lowercases a SQL text expression and collapses its
+// whitespace
+func normalizedContent(expr string) string {
+	return `btrim(regexp_replace(lower(` + expr + `), '\s+', ' ', 'g'))`
+}
+
+// defaultPostFilters returns the built-in filters configured from the
+// environment. Filters named in POST_FILTERS_FLAG flag posts instead of
+// rejecting them.
+func defaultPostFilters(db *gorm.DB) []PostFilter {
+	flag := make(map[string]bool)
+	for _, name := range strings.Split(os.Getenv("POST_FILTERS_FLAG"), ",") {
+		flag[strings.TrimSpace(name)] = true
+	}
+
+	filters := []PostFilter{
+		MaxLengthFilter{MaxLength: envInt("POST_MAX_LENGTH", defaultMaxPostLength), Flag: flag["max_length"]},
+	}
+
+	var banned []string
+	for _, word := range strings.Split(os.Getenv("BANNED_WORDS"), ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			banned = append(banned, word)
+		}
+	}
+	if len(banned) > 0 {
+		filters = append(filters, BannedWordsFilter{Words: banned, Flag: flag["banned_words"]})
+	}
+
+	filters = append(filters, LinkLimitFilter{MaxLinks: envInt("POST_MAX_LINKS", defaultMaxPostLinks), Flag: flag["links"]})
+
+	// Duplicate detection is off unless a window is configured
+	if window, err := time.ParseDuration(os.Getenv("DUPLICATE_POST_WINDOW")); err == nil && window > 0 {
+		filters = append(filters, DuplicateFilter{DB: db, Window: window, Flag: flag["duplicate"]})
+	}
+	return filters
+}
+
+// envInt returns a non-negative integer setting, or def when it is unset
+// or invalid
+func envInt(key string, def int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value >= 0 {
+		return value
+	}
+	return def
+}
+
+// RegisterFilter adds filters that run on every new post and every edit of
+// a post's content, after the ones already registered. Register filters
+// before the server starts serving.
+func (s *PostServer) RegisterFilter(filters ...PostFilter) {
+	s.filters = append(s.filters, filters...)
+}
+
+// filterPost runs every filter on a new or edited post. Rejections are returned
+// together as one InvalidArgument error with a BadRequest field violation
+// and an ErrorInfo per filter. Otherwise the reasons the post was flagged
+// for are returned, prefixed with their filter's name.
+func (s *PostServer) filterPost(ctx context.Context, post PostCandidate) ([]string, error) {
+	var flags, reasons []string
+	badRequest := &errdetails.BadRequest{}
+	details := []protoadapt.MessageV1{badRequest}
+	for _, filter := range s.filters {
+		result, err := filter.Check(ctx, post)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to run %s filter: %v", filter.Name(), err)
+		}
+
+		switch result.Verdict {
+		case FilterFlag:
+			flags = append(flags, filter.Name()+": "+result.Reason)
+		case FilterReject:
+			field := result.Field
+			if field == "" {
+				field = "content"
+			}
+			reasons = append(reasons, result.Reason)
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: result.Reason,
+			})
+			details = append(details, &errdetails.ErrorInfo{
+				Reason: strings.ToUpper(filter.Name()),
+				Domain: filterErrorDomain,
+				Metadata: map[string]string{
+					"field": field,
+				},
+			})
+		}
+	}
+
+	if len(reasons) == 0 {
+		return flags, nil
+	}
+
+	st := status.New(codes.InvalidArgument, "post rejected: "+strings.Join(reasons, "; "))
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		log.Printf("Failed to attach filter details: %v", err)
+		return nil, st.Err()
+	}
+	return nil, withDetails.Err()
+}
+
+// flagPost queues a post that filters flagged for moderators, as one open
+// report listing every reason. A post that is already queued by the
+// filters keeps its open report.
+func flagPost(tx *gorm.DB, postID string, flags []string) error {
+	details := strings.Join(flags, "; ")
+	if utf8.RuneCountInString(details) > maxReportDetailsLength {
+		details = string([]rune(details)[:maxReportDetailsLength])
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.Report{
+		PostID:     postID,
+		ReporterID: filterReporterID,
+		Reason:     models.ReportOther,
+		Details:    details,
+	}).Error
+}
@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"muze/internal/cache"
+	"muze/internal/messaging"
+	"muze/internal/models"
+	pb "muze/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *UserServer) Follow(ctx context.Context, req *pb.FollowRequest) (*pb.FollowResponse, error) {
+	// Follows are always recorded for the authenticated caller
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id is required")
+	}
+	if req.UserId == claims.UserID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot follow yourself")
+	}
+
+	// The unique (follower_id, followee_id) index rejects a second follow
+	follow := models.Follow{
+		FollowerID: claims.UserID,
+		FolloweeID: req.UserId,
+	}
+	if err := s.db.Create(&follow).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, status.Errorf(codes.AlreadyExists, "already following this user")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to follow user: %v", err)
+	}
+
+	// The timeline is rebuilt with the new followee's posts on next read
+	cache.InvalidateTimeline(claims.UserID)
+
+	// Publish to NATS for notifications
+	messaging.PublishUserFollowed(claims.UserID, req.UserId)
+
+	return &pb.FollowResponse{UserId: req.UserId, Following: true}, nil
+}
+
+func (s *UserServer) Unfollow(ctx context.Context, req *pb.FollowRequest) (*pb.FollowResponse, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unfollowing someone who is not followed is a no-op
+	result := s.db.Where("follower_id = ? AND followee_id = ?", claims.UserID, req.UserId).Delete(&models.Follow{})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unfollow user: %v", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		cache.InvalidateTimeline(claims.UserID)
+
+		// Large accounts that lost enough followers go back to fan-out-on-write
+		var followers int64
+		err := s.db.Model(&models.Follow{}).Where("followee_id = ?", req.UserId).Count(&followers).Error
+		if err == nil {
+			_, err = leaveFanOutOnRead(s.db, req.UserId, followers)
+		}
+		if err != nil {
+			log.Printf("Failed to update fan-out of %s: %v", req.UserId, err)
+		}
+	}
+
+	return &pb.FollowResponse{UserId: req.UserId, Following: false}, nil
+}
+
+// ListFollowers returns the users following req.UserId, most recent first
+func (s *UserServer) ListFollowers(ctx context.Context, req *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return s.listFollows(req, "followee_id", func(f models.Follow) string { return f.FollowerID })
+}
+
+// ListFollowing returns the users req.UserId follows, most recent first
+func (s *UserServer) ListFollowing(ctx context.Context, req *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return s.listFollows(req, "follower_id", func(f models.Follow) string { return f.FolloweeID })
+}
+
+// listFollows pages the follows whose column matches req.UserId using
+// keyset pagination on (created_at, id) and hydrates the other side
+func (s *UserServer) listFollows(req *pb.ListFollowsRequest, column string, other func(models.Follow) string) (*pb.ListFollowsResponse, error) {
+	limit := pageSize(req.First)
+	query := s.db.Where(column+" = ?", req.UserId)
+	if req.After != "" {
+		createdAt, id, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(created_at, id) < (?, ?)", createdAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var follows []models.Follow
+	err := query.Order("created_at DESC, id DESC").Limit(limit + 1).Find(&follows).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get follows: %v", err)
+	}
+
+	hasNextPage := len(follows) > limit
+	if hasNextPage {
+		follows = follows[:limit]
+	}
+
+	ids := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		ids = append(ids, other(follow))
+	}
+	users, err := s.loadUsers(ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get users: %v", err)
+	}
+
+	edges := make([]*pb.UserEdge, 0, len(follows))
+	for _, follow := range follows {
+		// Users without a profile are listed by ID only
+		user, ok := users[other(follow)]
+		if !ok {
+			user = &pb.User{Id: other(follow)}
+		}
+		edges = append(edges, &pb.UserEdge{
+			User:   user,
+			Cursor: encodeCursor(follow.CreatedAt, follow.ID),
+		})
+	}
+
+	pageInfo := buildPageInfo(edges, req.After != "", hasNextPage)
+
+	return &pb.ListFollowsResponse{Edges: edges, PageInfo: pageInfo}, nil
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"muze/internal/models"
+	pb "muze/proto"
+)
+
+// HomeFeed returns the posts of the users the caller follows, newest first.
+// Pages are served from the caller's Redis timeline when possible.
+func (s *PostServer) HomeFeed(ctx context.Context, req *pb.HomeFeedRequest) (*pb.GetPostsResponse, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(req.First)
+	resp, ok, err := s.getHomeFeedFromTimeline(claims.UserID, req.After, limit)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return s.expandPage(ctx, resp), nil
+	}
+
+	followees := s.db.Model(&models.Follow{}).Select("followee_id").Where("follower_id = ?", claims.UserID)
+	query := s.db.Model(&models.Post{}).Scopes(visiblePosts).Where("author_id IN (?)", followees)
+
+	resp, err = s.queryPostsPage(query, req.After, limit)
+	if err != nil {
+		return nil, err
+	}
+	return s.expandPage(ctx, resp), nil
+}
@@ -0,0 +1,669 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"muze/internal/models"
+	pb "muze/proto"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultMaxUploadBytes limits uploads unless MEDIA_MAX_BYTES is set
+	defaultMaxUploadBytes = 10 << 20
+
+	// maxUploadPixels rejects images that would take too much memory to
+	// decode, whatever their file size
+	maxUploadPixels = 40_000_000
+
+	// maxGIFFrames and maxGIFPixels bound animations, whose frames are
+	// all decoded at the logical screen size
+	maxGIFFrames = 1000
+	maxGIFPixels = 100_000_000
+
+	// thumbnailSize bounds both sides of generated thumbnails
+	thumbnailSize = 320
+
+	// blurhashSourceSize bounds the image blurhashes are computed from;
+	// the hash only keeps a few components, so more detail is wasted
+	blurhashSourceSize = 32
+
+	// maxPostMedia is the number of attachments a post can have
+	maxPostMedia = 4
+
+	maxAltLength = 1500
+
+	// maxImageURLLength bounds image URLs supplied by clients
+	maxImageURLLength = 2048
+
+	jpegQuality = 85
+)
+
+var errInvalidImage = errors.New("media is not a valid image")
+
+// mediaExtensions lists the accepted types, detected from file contents,
+// and the extension stored files get. WebP is converted to PNG since it
+// cannot be re-encoded.
+var mediaExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+	"image/webp": "png",
+}
+
+// maxUploadBytes returns the configured upload size limit
+func maxUploadBytes() int64 {
+	if limit, err := strconv.ParseInt(os.Getenv("MEDIA_MAX_BYTES"), 10, 64); err == nil && limit > 0 {
+		return limit
+	}
+	return defaultMaxUploadBytes
+}
+
+// Helper function to convert Go model to protobuf Media
+func convertToProtoMedia(media models.Media) *pb.Media {
+	return &pb.Media{
+		Id:           media.ID,
+		Url:          media.URL,
+		ThumbnailUrl: media.ThumbnailURL,
+		ContentType:  media.ContentType,
+		Width:        int32(media.Width),
+		Height:       int32(media.Height),
+		Size:         media.Size,
+		Blurhash:     media.Blurhash,
+	}
+}
+
+var postMediaTypes = map[string]pb.PostMediaType{
+	models.MediaImage: pb.PostMediaType_POST_MEDIA_TYPE_IMAGE,
+	models.MediaGIF:   pb.PostMediaType_POST_MEDIA_TYPE_GIF,
+}
+
+// Helper function to convert post attachments to protobuf PostMedia
+func convertToProtoPostMedia(attachments []models.PostMedia) []*pb.PostMedia {
+	result := make([]*pb.PostMedia, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, &pb.PostMedia{
+			Id:           attachment.ID,
+			Type:         postMediaTypes[attachment.Type],
+			Url:          attachment.URL,
+			ThumbnailUrl: attachment.ThumbnailURL,
+			Width:        int32(attachment.Width),
+			Height:       int32(attachment.Height),
+			Alt:          attachment.Alt,
+			Blurhash:     attachment.Blurhash,
+		})
+	}
+	return result
+}
+
+// UploadMedia receives an image streamed by the caller, re-encodes it
+// without metadata, stores it with a thumbnail and returns a media ID that
+// CreatePost accepts.
+func (s *PostServer) UploadMedia(stream pb.PostService_UploadMediaServer) error {
+	claims, err := requireUser(stream.Context())
+	if err != nil {
+		return err
+	}
+	if s.storage == nil {
+		return status.Errorf(codes.Unavailable, "media storage is not configured")
+	}
+
+	// The first message describes the file
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "metadata is required")
+	}
+	if err != nil {
+		return err
+	}
+	metadata := req.GetMetadata()
+	if metadata == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message must contain metadata")
+	}
+
+	limit := maxUploadBytes()
+	if metadata.Size > limit {
+		return status.Errorf(codes.InvalidArgument, "media exceeds %d bytes", limit)
+	}
+
+	var data bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetMetadata() != nil {
+			return status.Errorf(codes.InvalidArgument, "metadata can only be sent once")
+		}
+
+		chunk := req.GetChunk()
+		if int64(data.Len()+len(chunk)) > limit {
+			return status.Errorf(codes.InvalidArgument, "media exceeds %d bytes", limit)
+		}
+		data.Write(chunk)
+	}
+	if data.Len() == 0 {
+		return status.Errorf(codes.InvalidArgument, "media cannot be empty")
+	}
+
+	processed, err := processImage(data.Bytes())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	media, err := s.storeMedia(stream.Context(), claims.UserID, processed)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to store media: %v", err)
+	}
+
+	return stream.SendAndClose(convertToProtoMedia(*media))
+}
+
+// storeMedia writes a processed image and its thumbnail to storage and
+// records them. Stored files are removed again if recording fails.
+func (s *PostServer) storeMedia(ctx context.Context, ownerID string, processed *processedImage) (*models.Media, error) {
+	id := uuid.NewString()
+	media := models.Media{
+		ID:           id,
+		OwnerID:      ownerID,
+		ContentType:  processed.contentType,
+		Size:         int64(len(processed.data)),
+		Width:        processed.width,
+		Height:       processed.height,
+		Key:          fmt.Sprintf("media/%s.%s", id, mediaExtensions[processed.contentType]),
+		ThumbnailKey: fmt.Sprintf("media/%s_thumb.%s", id, mediaExtensions[processed.thumbnailType]),
+		Blurhash:     processed.blurhash,
+	}
+	media.URL = s.storage.URL(media.Key)
+	media.ThumbnailURL = s.storage.URL(media.ThumbnailKey)
+
+	if err := s.storage.Put(ctx, media.Key, bytes.NewReader(processed.data), media.Size, media.ContentType); err != nil {
+		return nil, err
+	}
+	err := s.storage.Put(ctx, media.ThumbnailKey, bytes.NewReader(processed.thumbnail), int64(len(processed.thumbnail)), processed.thumbnailType)
+	if err == nil {
+		err = s.db.Create(&media).Error
+	}
+	if err != nil {
+		for _, key := range []string{media.Key, media.ThumbnailKey} {
+			if err := s.storage.Delete(context.WithoutCancel(ctx), key); err != nil {
+				log.Printf("Failed to remove media %s: %v", key, err)
+			}
+		}
+		return nil, err
+	}
+
+	return &media, nil
+}
+
+// unusedMedia returns the caller's upload with the given ID if no post
+// uses it yet
+func (s *PostServer) unusedMedia(ownerID, mediaID string) (*models.Media, error) {
+	if !isUUID(mediaID) {
+		return nil, status.Errorf(codes.NotFound, "media %s not found", mediaID)
+	}
+
+	var media models.Media
+	err := s.db.Where("id = ? AND owner_id = ?", mediaID, ownerID).First(&media).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "media %s not found", mediaID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get media: %v", err)
+	}
+	if media.PostID != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "media %s is already attached to a post", mediaID)
+	}
+	return &media, nil
+}
+
+// postAttachments resolves the attachments requested for a new post, in
+// order. A client-supplied image URL becomes a single attachment.
+func (s *PostServer) postAttachments(ownerID string, req *pb.CreatePostRequest) ([]models.PostMedia, error) {
+	requested := req.Media
+	if req.MediaId != "" {
+		if len(requested) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "media_id cannot be combined with media")
+		}
+		requested = []*pb.MediaAttachment{{MediaId: req.MediaId}}
+	}
+	if req.ImageUrl != nil {
+		if len(requested) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "image_url cannot be combined with media_id or media")
+		}
+		if err := validateImageURL(req.ImageUrl.Value); err != nil {
+			return nil, err
+		}
+		return imageURLAttachments(req.ImageUrl.Value), nil
+	}
+	if len(requested) > maxPostMedia {
+		return nil, status.Errorf(codes.InvalidArgument, "posts can have at most %d attachments", maxPostMedia)
+	}
+
+	attachments := make([]models.PostMedia, 0, len(requested))
+	seen := make(map[string]bool, len(requested))
+	for _, attachment := range requested {
+		alt := strings.TrimSpace(attachment.Alt)
+		if utf8.RuneCountInString(alt) > maxAltLength {
+			return nil, status.Errorf(codes.InvalidArgument, "alt text cannot exceed %d characters", maxAltLength)
+		}
+		if seen[attachment.MediaId] {
+			return nil, status.Errorf(codes.InvalidArgument, "media %s is attached twice", attachment.MediaId)
+		}
+		seen[attachment.MediaId] = true
+
+		media, err := s.unusedMedia(ownerID, attachment.MediaId)
+		if err != nil {
+			return nil, err
+		}
+
+		mediaType := models.MediaImage
+		if media.ContentType == "image/gif" {
+			mediaType = models.MediaGIF
+		}
+		attachments = append(attachments, models.PostMedia{
+			MediaID:      &media.ID,
+			Type:         mediaType,
+			URL:          media.URL,
+			ThumbnailURL: media.ThumbnailURL,
+			Width:        media.Width,
+			Height:       media.Height,
+			Alt:          alt,
+			Blurhash:     media.Blurhash,
+		})
+	}
+	return attachments, nil
+}
+
+// validateImageURL checks an image URL supplied by a client, which other
+// clients load as is. Only absolute http and https URLs without
+// credentials are accepted; an empty URL removes the image.
+func validateImageURL(imageURL string) error {
+	if imageURL == "" {
+		return nil
+	}
+	if len(imageURL) > maxImageURLLength {
+		return status.Errorf(codes.InvalidArgument, "image_url cannot exceed %d characters", maxImageURLLength)
+	}
+	parsed, err := url.Parse(imageURL)
+	if err != nil || parsed.Scheme != "http" && parsed.Scheme != "https" || parsed.Hostname() == "" || parsed.User != nil {
+		return status.Errorf(codes.InvalidArgument, "image_url must be an http or https URL with a host")
+	}
+	return nil
+}
+
+// imageURLAttachments returns the legacy attachment for an image URL
+// supplied by a client, whose size is unknown, or none for an empty URL
+func imageURLAttachments(imageURL string) []models.PostMedia {
+	if imageURL == "" {
+		return nil
+	}
+	return []models.PostMedia{{Type: models.MediaImage, URL: imageURL}}
+}
+
+// savePostMedia replaces the attachments of a post, numbering them in
+// order, and claims the uploads they use. Uploads the post stops using are
+// released so their owner can attach them again. Claiming fails if another
+// post took an upload concurrently.
+func savePostMedia(tx *gorm.DB, post *models.Post, attachments []models.PostMedia) error {
+	if err := tx.Where("post_id = ?", post.ID).Delete(&models.PostMedia{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&models.Media{}).Where("post_id = ?", post.ID).Update("post_id", nil).Error; err != nil {
+		return err
+	}
+
+	for i := range attachments {
+		attachments[i].ID = uuid.NewString()
+		attachments[i].PostID = post.ID
+		attachments[i].Position = i
+
+		if attachments[i].MediaID == nil {
+			continue
+		}
+		result := tx.Model(&models.Media{}).
+			Where("id = ? AND post_id IS NULL", *attachments[i].MediaID).
+			Update("post_id", post.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return status.Errorf(codes.FailedPrecondition, "media %s is already attached to a post", *attachments[i].MediaID)
+		}
+	}
+	if len(attachments) > 0 {
+		if err := tx.Create(&attachments).Error; err != nil {
+			return err
+		}
+	}
+
+	// The post keeps a copy for rendering and the first image as image_url
+	post.Media = attachments
+	post.ImageURL = nil
+	if len(attachments) > 0 {
+		post.ImageURL = &attachments[0].URL
+	}
+	return tx.Model(post).Select("media", "image_url").Updates(post).Error
+}
+
+// processedImage is an upload re-encoded without metadata, with its thumbnail
+type processedImage struct {
+	data          []byte
+	contentType   string
+	width         int
+	height        int
+	thumbnail     []byte
+	thumbnailType string
+	blurhash      string
+}
+
+// processImage validates an uploaded image and re-encodes it. Encoding the
+// decoded pixels drops EXIF and any other metadata; the EXIF orientation
+// of JPEGs is applied first so photos keep their intended rotation.
+func processImage(data []byte) (*processedImage, error) {
+	contentType := http.DetectContentType(data)
+	if _, ok := mediaExtensions[contentType]; !ok {
+		return nil, fmt.Errorf("unsupported media type %s", contentType)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxUploadPixels {
+		return nil, fmt.Errorf("images can have at most %d pixels", maxUploadPixels)
+	}
+
+	var (
+		img    image.Image
+		output bytes.Buffer
+	)
+	switch contentType {
+	case "image/gif":
+		// Count the frames before decoding them; LZW compresses well
+		// enough for a small file to expand to gigabytes
+		frames, err := gifFrameCount(data)
+		if err != nil {
+			return nil, errInvalidImage
+		}
+		if frames > maxGIFFrames || frames*config.Width*config.Height > maxGIFPixels {
+			return nil, fmt.Errorf("animations can have at most %d frames and %d pixels in all", maxGIFFrames, maxGIFPixels)
+		}
+
+		// Keep every frame of animations
+		animation, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil || len(animation.Image) == 0 {
+			return nil, errInvalidImage
+		}
+		if err := gif.EncodeAll(&output, animation); err != nil {
+			return nil, err
+		}
+		img = animation.Image[0]
+
+	case "image/jpeg":
+		img, err = jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, errInvalidImage
+		}
+		img = applyOrientation(img, jpegOrientation(data))
+		if err := jpeg.Encode(&output, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+
+	default:
+		img, _, err = image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, errInvalidImage
+		}
+		contentType = "image/png"
+		if err := png.Encode(&output, img); err != nil {
+			return nil, err
+		}
+	}
+
+	bounds := img.Bounds()
+	if contentType == "image/gif" {
+		bounds = image.Rect(0, 0, config.Width, config.Height)
+	}
+	processed := &processedImage{
+		data:        output.Bytes(),
+		contentType: contentType,
+		width:       bounds.Dx(),
+		height:      bounds.Dy(),
+	}
+
+	// Thumbnails of photos stay JPEG; everything else keeps transparency
+	var thumbnail bytes.Buffer
+	if contentType == "image/jpeg" {
+		processed.thumbnailType = "image/jpeg"
+		err = jpeg.Encode(&thumbnail, resizeToFit(img, thumbnailSize), &jpeg.Options{Quality: jpegQuality})
+	} else {
+		processed.thumbnailType = "image/png"
+		err = png.Encode(&thumbnail, resizeToFit(img, thumbnailSize))
+	}
+	if err != nil {
+		return nil, err
+	}
+	processed.thumbnail = thumbnail.Bytes()
+	processed.blurhash = encodeBlurhash(resizeToFit(img, blurhashSourceSize))
+
+	return processed, nil
+}
+
+// gifFrameCount counts the frames of a GIF by walking its blocks without
+// decompressing them. It stops counting past maxGIFFrames.
+func gifFrameCount(data []byte) (int, error) {
+	errMalformed := errors.New("malformed gif")
+	if len(data) < 13 {
+		return 0, errMalformed
+	}
+
+	// Skip the header, the logical screen descriptor and the global color
+	// table
+	i := 13
+	if data[10]&0x80 != 0 {
+		i += 3 << (data[10]&0x07 + 1)
+	}
+
+	// skipSubBlocks moves i past a chain of data sub-blocks
+	skipSubBlocks := func() error {
+		for {
+			if i >= len(data) {
+				return errMalformed
+			}
+			size := int(data[i])
+			i += 1 + size
+			if size == 0 {
+				return nil
+			}
+		}
+	}
+
+	frames := 0
+	for frames <= maxGIFFrames {
+		if i >= len(data) {
+			return 0, errMalformed
+		}
+		switch data[i] {
+		case 0x21: // Extension: label, then sub-blocks
+			i += 2
+		case 0x2C: // Image descriptor, local color table, LZW code size
+			if i+10 > len(data) {
+				return 0, errMalformed
+			}
+			packed := data[i+9]
+			i += 10
+			if packed&0x80 != 0 {
+				i += 3 << (packed&0x07 + 1)
+			}
+			i++
+			frames++
+		case 0x3B: // Trailer
+			return frames, nil
+		default:
+			return 0, errMalformed
+		}
+		if err := skipSubBlocks(); err != nil {
+			return 0, err
+		}
+	}
+	return frames, nil
+}
+
+// resizeToFit scales img down so neither side exceeds size, keeping its
+// aspect ratio. Smaller images are returned unchanged.
+func resizeToFit(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= size && height <= size {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*size/width)
+		width = size
+	} else {
+		width = max(1, width*size/height)
+		height = size
+	}
+
+	thumbnail := image.NewNRGBA(image.Rect(0, 0, width, height))
+	xdraw.CatmullRom.Scale(thumbnail, thumbnail.Bounds(), img, bounds, draw.Src, nil)
+	return thumbnail
+}
+
+// jpegOrientation returns the EXIF orientation of a JPEG, from 1 (upright)
+// to 8, or 1 when there is none.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	// Walk the segments before the image data looking for APP1 Exif
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// exifOrientation reads the orientation tag from the first IFD of a TIFF
+// structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < entries; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+	return 1
+}
+
+// applyOrientation rotates and flips img so that it displays upright for
+// the given EXIF orientation
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+	w, h := bounds.Dx(), bounds.Dy()
+
+	// Orientations 5 to 8 swap width and height
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
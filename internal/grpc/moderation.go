@@ -0,0 +1,405 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"muze/internal/models"
+	pb "muze/proto"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxReportDetailsLength is the number of characters a reporter can add
+const maxReportDetailsLength = 1000
+
+var reportReasons = map[string]pb.ReportReason{
+	models.ReportSpam:           pb.ReportReason_REPORT_REASON_SPAM,
+	models.ReportHarassment:     pb.ReportReason_REPORT_REASON_HARASSMENT,
+	models.ReportHate:           pb.ReportReason_REPORT_REASON_HATE,
+	models.ReportViolence:       pb.ReportReason_REPORT_REASON_VIOLENCE,
+	models.ReportNudity:         pb.ReportReason_REPORT_REASON_NUDITY,
+	models.ReportMisinformation: pb.ReportReason_REPORT_REASON_MISINFORMATION,
+	models.ReportOther:          pb.ReportReason_REPORT_REASON_OTHER,
+}
+
+var moderationDecisions = map[string]pb.ModerationDecision{
+	models.ModerationDismiss:    pb.ModerationDecision_MODERATION_DECISION_DISMISS,
+	models.ModerationHidePost:   pb.ModerationDecision_MODERATION_DECISION_HIDE_POST,
+	models.ModerationRemovePost: pb.ModerationDecision_MODERATION_DECISION_REMOVE_POST,
+}
+
+var moderationActionTypes = map[string]pb.ModerationActionType{
+	models.ModerationDismiss:       pb.ModerationActionType_MODERATION_ACTION_TYPE_DISMISS,
+	models.ModerationHidePost:      pb.ModerationActionType_MODERATION_ACTION_TYPE_HIDE_POST,
+	models.ModerationRemovePost:    pb.ModerationActionType_MODERATION_ACTION_TYPE_REMOVE_POST,
+	models.ModerationSuspendAuthor: pb.ModerationActionType_MODERATION_ACTION_TYPE_SUSPEND_AUTHOR,
+}
+
+// modelValue returns the key that maps to value, the model's name for a
+// protobuf enum value
+func modelValue[V comparable](values map[string]V, value V) (string, bool) {
+	for name, v := range values {
+		if v == value {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// Helper function to convert a report to protobuf. post is only set for
+// moderators.
+func (s *PostServer) convertToProtoReport(report models.Report, post *models.Post) *pb.Report {
+	pbReport := &pb.Report{
+		Id:         report.ID,
+		PostId:     report.PostID,
+		ReporterId: report.ReporterID,
+		Reason:     reportReasons[report.Reason],
+		Details:    report.Details,
+		Status:     pb.ReportStatus_REPORT_STATUS_OPEN,
+		Decision:   moderationDecisions[report.Decision],
+		ResolvedAt: formatOptionalTime(report.ResolvedAt),
+		CreatedAt:  report.CreatedAt.Format(time.RFC3339),
+	}
+	if report.Status == models.ReportResolved {
+		pbReport.Status = pb.ReportStatus_REPORT_STATUS_RESOLVED
+	}
+	if report.ResolvedBy != nil {
+		pbReport.ResolvedBy = *report.ResolvedBy
+	}
+	if post != nil {
+		pbReport.Post = s.convertToProtoPost(*post)
+	}
+	return pbReport
+}
+
+// Helper function to convert an audit trail entry to protobuf
+func convertToProtoModerationAction(action models.ModerationAction) *pb.ModerationAction {
+	pbAction := &pb.ModerationAction{
+		Id:          action.ID,
+		ModeratorId: action.ModeratorID,
+		Type:        moderationActionTypes[action.Action],
+		Note:        action.Note,
+		CreatedAt:   action.CreatedAt.Format(time.RFC3339),
+	}
+	if action.ReportID != nil {
+		pbAction.ReportId = *action.ReportID
+	}
+	if action.PostID != nil {
+		pbAction.PostId = *action.PostID
+	}
+	if action.UserID != nil {
+		pbAction.UserId = *action.UserID
+	}
+	return pbAction
+}
+
+// checkNotSuspended keeps users suspended by a moderator from writing
+func (s *PostServer) checkNotSuspended(userID string) error {
+	var suspensions int64
+	if err := s.db.Model(&models.Suspension{}).Where("user_id = ?", userID).Count(&suspensions).Error; err != nil {
+		return status.Errorf(codes.Internal, "failed to check account: %v", err)
+	}
+	if suspensions > 0 {
+		return status.Errorf(codes.PermissionDenied, "account suspended")
+	}
+	return nil
+}
+
+// hidePost hides a post with its reposts, which it returns. A hidden reply
+// stops counting like a deleted one.
+func hidePost(tx *gorm.DB, post models.Post, now time.Time) ([]models.Post, error) {
+	var reposts []models.Post
+	if err := tx.Scopes(visiblePosts).Where("repost_of_id = ?", post.ID).Find(&reposts).Error; err != nil {
+		return nil, err
+	}
+
+	err := tx.Model(&models.Post{}).
+		Where("id = ? OR repost_of_id = ?", post.ID, post.ID).
+		Where("hidden_at IS NULL").
+		UpdateColumn("hidden_at", now).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if post.ParentID != nil {
+		if err := updatePostCounter(tx, *post.ParentID, "reply_count", -1); err != nil {
+			return nil, err
+		}
+	}
+	return reposts, nil
+}
+
+// ReportPost queues a post for moderators. A user can have one open report
+// per post.
+func (s *PostServer) ReportPost(ctx context.Context, req *pb.ReportPostRequest) (*pb.Report, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	reason, ok := modelValue(reportReasons, req.Reason)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "report reason is required")
+	}
+	details := strings.TrimSpace(req.Details)
+	if utf8.RuneCountInString(details) > maxReportDetailsLength {
+		return nil, status.Errorf(codes.InvalidArgument, "details cannot be longer than %d characters", maxReportDetailsLength)
+	}
+
+	post, err := findSharedPost(s.db, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.AuthorID == claims.UserID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot report your own post")
+	}
+
+	// The partial unique index on open (post_id, reporter_id) rejects a
+	// second report even when two requests race
+	report := models.Report{
+		PostID:     post.ID,
+		ReporterID: claims.UserID,
+		Reason:     reason,
+		Details:    details,
+		Status:     models.ReportOpen,
+	}
+	if err := s.db.Create(&report).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, status.Errorf(codes.AlreadyExists, "post already reported")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to report post: %v", err)
+	}
+
+	return s.convertToProtoReport(report, nil), nil
+}
+
+// ListReports pages the moderation queue, oldest reports first. Reports
+// come with their post, even when it was hidden or removed.
+func (s *PostServer) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	if _, err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	reportStatus := models.ReportOpen
+	if req.Status == pb.ReportStatus_REPORT_STATUS_RESOLVED {
+		reportStatus = models.ReportResolved
+	}
+
+	limit := pageSize(req.First)
+	query := s.db.Where("status = ?", reportStatus)
+	if req.After != "" {
+		createdAt, id, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(created_at, id) > (?, ?)", createdAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var reports []models.Report
+	if err := query.Order("created_at, id").Limit(limit + 1).Find(&reports).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reports: %v", err)
+	}
+
+	hasNextPage := len(reports) > limit
+	if hasNextPage {
+		reports = reports[:limit]
+	}
+
+	postIDs := make([]string, 0, len(reports))
+	for _, report := range reports {
+		postIDs = append(postIDs, report.PostID)
+	}
+	var posts []models.Post
+	if len(postIDs) > 0 {
+		if err := s.db.Unscoped().Where("id IN ?", postIDs).Find(&posts).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get reported posts: %v", err)
+		}
+	}
+	byID := make(map[string]*models.Post, len(posts))
+	for i := range posts {
+		byID[posts[i].ID] = &posts[i]
+	}
+
+	edges := make([]*pb.ReportEdge, 0, len(reports))
+	for _, report := range reports {
+		edges = append(edges, &pb.ReportEdge{
+			Report: s.convertToProtoReport(report, byID[report.PostID]),
+			Cursor: encodeCursor(report.CreatedAt, report.ID),
+		})
+	}
+
+	pageInfo := buildPageInfo(edges, req.After != "", hasNextPage)
+
+	return &pb.ListReportsResponse{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// ResolveReport applies a moderator's decision to a reported post and
+// records it in the audit trail. Hiding or removing the post resolves all
+// of its open reports; dismissing resolves only this one.
+func (s *PostServer) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.Report, error) {
+	claims, err := requireModerator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decision, ok := modelValue(moderationDecisions, req.Decision)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "decision is required")
+	}
+	if !isUUID(req.ReportId) {
+		return nil, status.Errorf(codes.NotFound, "report not found")
+	}
+	note := strings.TrimSpace(req.Note)
+	now := time.Now().Truncate(time.Microsecond)
+
+	var report models.Report
+	var post models.Post
+	var reposts []models.Post
+	removed := false
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the report so two moderators cannot resolve it at once
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", req.ReportId).First(&report).Error
+		if err == gorm.ErrRecordNotFound {
+			return status.Errorf(codes.NotFound, "report not found")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get report: %v", err)
+		}
+		if report.Status != models.ReportOpen {
+			return status.Errorf(codes.FailedPrecondition, "report already resolved")
+		}
+
+		if err := tx.Unscoped().Where("id = ?", report.PostID).First(&post).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to get post: %v", err)
+		}
+
+		// Posts already hidden or removed are left as they are
+		resolved := tx.Model(&models.Report{}).Where("id = ?", report.ID)
+		switch decision {
+		case models.ModerationHidePost:
+			resolved = tx.Model(&models.Report{}).Where("post_id = ? AND status = ?", post.ID, models.ReportOpen)
+			if post.HiddenAt == nil && !post.DeletedAt.Valid {
+				if reposts, err = hidePost(tx, post, now); err != nil {
+					return err
+				}
+				removed = true
+			}
+		case models.ModerationRemovePost:
+			resolved = tx.Model(&models.Report{}).Where("post_id = ? AND status = ?", post.ID, models.ReportOpen)
+			if !post.DeletedAt.Valid {
+				if reposts, err = deletePost(tx, post); err != nil {
+					return err
+				}
+				removed = true
+			}
+		}
+
+		err = resolved.UpdateColumns(map[string]interface{}{
+			"status":      models.ReportResolved,
+			"decision":    decision,
+			"resolved_by": claims.UserID,
+			"resolved_at": now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		actions := []models.ModerationAction{{
+			ModeratorID: claims.UserID,
+			Action:      decision,
+			ReportID:    &report.ID,
+			PostID:      &post.ID,
+			Note:        note,
+		}}
+		if req.SuspendAuthor {
+			suspension := models.Suspension{UserID: post.AuthorID, SuspendedBy: claims.UserID, Reason: note}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&suspension).Error; err != nil {
+				return err
+			}
+			actions = append(actions, models.ModerationAction{
+				ModeratorID: claims.UserID,
+				Action:      models.ModerationSuspendAuthor,
+				ReportID:    &report.ID,
+				PostID:      &post.ID,
+				UserID:      &post.AuthorID,
+				Note:        note,
+			})
+		}
+		if err := tx.Create(&actions).Error; err != nil {
+			return err
+		}
+
+		// Reload the resolution written by the database
+		return tx.Where("id = ?", report.ID).First(&report).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to resolve report: %v", err)
+	}
+
+	// Hidden posts drop out of the caches and timelines like deleted ones,
+	// and subscribers are told they are gone
+	if removed {
+		forgetRemovedPost(post, reposts, claims.UserID)
+	}
+
+	return s.convertToProtoReport(report, &post), nil
+}
+
+// ListModerationActions pages the audit trail of moderator decisions,
+// newest first
+func (s *PostServer) ListModerationActions(ctx context.Context, req *pb.ListModerationActionsRequest) (*pb.ListModerationActionsResponse, error) {
+	if _, err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(req.First)
+	query := s.db.Model(&models.ModerationAction{})
+	if req.After != "" {
+		createdAt, id, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(created_at, id) < (?, ?)", createdAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var actions []models.ModerationAction
+	if err := query.Order("created_at DESC, id DESC").Limit(limit + 1).Find(&actions).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get moderation actions: %v", err)
+	}
+
+	hasNextPage := len(actions) > limit
+	if hasNextPage {
+		actions = actions[:limit]
+	}
+
+	edges := make([]*pb.ModerationActionEdge, 0, len(actions))
+	for _, action := range actions {
+		edges = append(edges, &pb.ModerationActionEdge{
+			Action: convertToProtoModerationAction(action),
+			Cursor: encodeCursor(action.CreatedAt, action.ID),
+		})
+	}
+
+	pageInfo := buildPageInfo(edges, req.After != "", hasNextPage)
+
+	return &pb.ListModerationActionsResponse{Edges: edges, PageInfo: pageInfo}, nil
+}
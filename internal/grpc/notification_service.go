@@ -0,0 +1,353 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"muze/internal/database"
+	"muze/internal/messaging"
+	"muze/internal/models"
+	pb "muze/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxNotificationActors is the number of recent actors kept per notification
+	maxNotificationActors = 10
+
+	notificationQueue = "notifications"
+)
+
+type NotificationServer struct {
+	pb.UnimplementedNotificationServiceServer
+	db *gorm.DB
+}
+
+func NewNotificationServer() *NotificationServer {
+	return &NotificationServer{
+		db: database.DB,
+	}
+}
+
+var notificationTypes = map[string]pb.NotificationType{
+	models.NotificationLike:    pb.NotificationType_NOTIFICATION_TYPE_LIKE,
+	models.NotificationComment: pb.NotificationType_NOTIFICATION_TYPE_COMMENT,
+	models.NotificationFollow:  pb.NotificationType_NOTIFICATION_TYPE_FOLLOW,
+	models.NotificationMention: pb.NotificationType_NOTIFICATION_TYPE_MENTION,
+	models.NotificationRepost:  pb.NotificationType_NOTIFICATION_TYPE_REPOST,
+}
+
+var notificationActions = map[string]string{
+	models.NotificationLike:    "liked your post",
+	models.NotificationComment: "commented on your post",
+	models.NotificationFollow:  "followed you",
+	models.NotificationMention: "mentioned you in a post",
+	models.NotificationRepost:  "reposted your post",
+}
+
+// Helper function to convert Go model to protobuf Notification. names maps
+// actor IDs to display names for the message.
+func convertToProtoNotification(notification models.Notification, names map[string]string) *pb.Notification {
+	var postID string
+	if notification.PostID != nil {
+		postID = *notification.PostID
+	}
+
+	return &pb.Notification{
+		Id:         notification.ID,
+		Type:       notificationTypes[notification.Type],
+		PostId:     postID,
+		ActorIds:   notification.ActorIDs,
+		ActorCount: int32(notification.ActorCount),
+		Message:    notificationMessage(notification, names),
+		Read:       notification.ReadAt != nil,
+		Timestamp:  notification.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// notificationMessage summarizes a notification, like "Alice and 4 others
+// liked your post"
+func notificationMessage(notification models.Notification, names map[string]string) string {
+	name := func(i int) string {
+		if i < len(notification.ActorIDs) && names[notification.ActorIDs[i]] != "" {
+			return names[notification.ActorIDs[i]]
+		}
+		return "Someone"
+	}
+
+	action := notificationActions[notification.Type]
+	switch {
+	case notification.ActorCount <= 1:
+		return fmt.Sprintf("%s %s", name(0), action)
+	case notification.ActorCount == 2:
+		return fmt.Sprintf("%s and %s %s", name(0), name(1), action)
+	default:
+		return fmt.Sprintf("%s and %d others %s", name(0), notification.ActorCount-1, action)
+	}
+}
+
+// actorNames returns the display names of the first two actors of each
+// notification, the ones named in messages
+func (s *NotificationServer) actorNames(notifications []models.Notification) map[string]string {
+	var ids []string
+	for _, notification := range notifications {
+		for i := 0; i < len(notification.ActorIDs) && i < 2; i++ {
+			if isUUID(notification.ActorIDs[i]) {
+				ids = append(ids, notification.ActorIDs[i])
+			}
+		}
+	}
+
+	names := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return names
+	}
+
+	var users []models.User
+	if err := s.db.Select("id", "name").Where("id IN ?", ids).Find(&users).Error; err != nil {
+		log.Printf("Failed to load notification actors: %v", err)
+		return names
+	}
+	for _, user := range users {
+		names[user.ID] = user.Name
+	}
+	return names
+}
+
+// StartNotificationConsumer turns post and follow events into
+// notifications. Replicas share the work through a NATS queue group.
+func (s *NotificationServer) StartNotificationConsumer() error {
+	handler := func(subject string, data []byte) {
+		if err := s.handleEvent(subject, data); err != nil {
+			log.Printf("Failed to create notification for %s event: %v", subject, err)
+		}
+	}
+
+	if _, err := messaging.QueueSubscribeToPostEvents(notificationQueue, handler); err != nil {
+		return err
+	}
+	_, err := messaging.QueueSubscribeToUserEvents(notificationQueue, handler)
+	return err
+}
+
+func (s *NotificationServer) handleEvent(subject string, data []byte) error {
+	switch subject {
+	case messaging.SubjectPostLiked:
+		var event messaging.PostLikedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		return s.notify(event.AuthorID, event.UserID, models.NotificationLike, &event.PostID)
+
+	case messaging.SubjectPostCommented:
+		var event messaging.PostCommentedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		return s.notify(event.AuthorID, event.UserID, models.NotificationComment, &event.PostID)
+
+	case messaging.SubjectPostMentioned:
+		var event messaging.PostMentionedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		return s.notify(event.UserID, event.AuthorID, models.NotificationMention, &event.PostID)
+
+	case messaging.SubjectPostReposted:
+		var event messaging.PostRepostedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		return s.notify(event.AuthorID, event.UserID, models.NotificationRepost, &event.PostID)
+
+	case messaging.SubjectUserFollowed:
+		var event messaging.UserFollowedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		return s.notify(event.FolloweeID, event.FollowerID, models.NotificationFollow, nil)
+	}
+
+	return nil
+}
+
+// notify records that actorID acted on userID, folding the action into
+// the user's unread notification for the same type and post. Repeated
+// actions by the same actor are ignored.
+func (s *NotificationServer) notify(userID, actorID, notificationType string, postID *string) error {
+	// Users are not notified about their own actions
+	if userID == "" || actorID == "" || userID == actorID {
+		return nil
+	}
+
+	groupKey := ""
+	if postID != nil {
+		groupKey = *postID
+	}
+
+	var notification models.Notification
+	added := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// The partial unique index keeps one unread notification per group
+		err := tx.Raw(`INSERT INTO notifications (user_id, type, post_id, group_key, actor_ids, actor_count, created_at, updated_at)
+			VALUES (?, ?, ?, ?, '[]', 0, now(), now())
+			ON CONFLICT (user_id, type, group_key) WHERE read_at IS NULL
+			DO UPDATE SET user_id = EXCLUDED.user_id
+			RETURNING id`, userID, notificationType, postID, groupKey).Scan(&notification.ID).Error
+		if err != nil {
+			return err
+		}
+
+		actor := models.NotificationActor{NotificationID: notification.ID, ActorID: actorID}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&actor)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		added = true
+
+		// Keep the most recent actors first
+		return tx.Raw(`UPDATE notifications SET
+				actor_count = actor_count + 1,
+				actor_ids = jsonb_path_query_array(jsonb_build_array(?::text) || actor_ids, ?::jsonpath),
+				updated_at = now()
+			WHERE id = ?
+			RETURNING *`, actorID, fmt.Sprintf("$[0 to %d]", maxNotificationActors-1), notification.ID).Scan(&notification).Error
+	})
+	if err != nil || !added {
+		return err
+	}
+
+	// Deliver to subscribed clients
+	return messaging.PublishNotification(notification)
+}
+
+func (s *NotificationServer) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	// Users can only read their own notifications
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(req.First)
+	query := s.db.Where("user_id = ?", claims.UserID)
+	if req.After != "" {
+		updatedAt, id, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(updated_at, id) < (?, ?)", updatedAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var notifications []models.Notification
+	err = query.Order("updated_at DESC, id DESC").Limit(limit + 1).Find(&notifications).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notifications: %v", err)
+	}
+
+	hasNextPage := len(notifications) > limit
+	if hasNextPage {
+		notifications = notifications[:limit]
+	}
+
+	unread, err := s.unreadCount(claims.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count notifications: %v", err)
+	}
+
+	names := s.actorNames(notifications)
+	edges := make([]*pb.NotificationEdge, 0, len(notifications))
+	for _, notification := range notifications {
+		edges = append(edges, &pb.NotificationEdge{
+			Notification: convertToProtoNotification(notification, names),
+			Cursor:       encodeCursor(notification.UpdatedAt, notification.ID),
+		})
+	}
+
+	pageInfo := buildPageInfo(edges, req.After != "", hasNextPage)
+
+	return &pb.ListNotificationsResponse{Edges: edges, PageInfo: pageInfo, UnreadCount: int32(unread)}, nil
+}
+
+func (s *NotificationServer) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.UnreadCountResponse, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.db.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", claims.UserID)
+	if len(req.Ids) > 0 {
+		// IDs that cannot exist are skipped rather than failing the request
+		var ids []string
+		for _, id := range req.Ids {
+			if isUUID(id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return s.GetUnreadCount(ctx, &pb.GetUnreadCountRequest{})
+		}
+		query = query.Where("id IN ?", ids)
+	}
+
+	if err := query.UpdateColumn("read_at", time.Now()).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mark notifications read: %v", err)
+	}
+
+	return s.GetUnreadCount(ctx, &pb.GetUnreadCountRequest{})
+}
+
+func (s *NotificationServer) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.UnreadCountResponse, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := s.unreadCount(claims.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count notifications: %v", err)
+	}
+	return &pb.UnreadCountResponse{UnreadCount: int32(unread)}, nil
+}
+
+func (s *NotificationServer) unreadCount(userID string) (int64, error) {
+	var count int64
+	err := s.db.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userID).Count(&count).Error
+	return count, err
+}
+
+// StreamNotifications sends the caller's notifications as they are created
+// or updated
+func (s *NotificationServer) StreamNotifications(req *pb.StreamNotificationsRequest, stream pb.NotificationService_StreamNotificationsServer) error {
+	claims, err := requireUser(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	subscription, err := messaging.SubscribeToNotifications(claims.UserID, func(data []byte) {
+		var notification models.Notification
+		if err := json.Unmarshal(data, &notification); err != nil {
+			log.Printf("Failed to decode notification: %v", err)
+			return
+		}
+
+		names := s.actorNames([]models.Notification{notification})
+		if err := stream.Send(convertToProtoNotification(notification, names)); err != nil {
+			log.Printf("Failed to send notification: %v", err)
+		}
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to notifications: %v", err)
+	}
+	defer subscription.Unsubscribe()
+
+	// Keep the stream alive
+	<-stream.Context().Done()
+	return nil
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"errors"
+	pb "muze/proto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// pageSize normalizes a requested page size
+func pageSize(first int32) int {
+	if first <= 0 {
+		return defaultPageSize
+	}
+	if first > maxPageSize {
+		return maxPageSize
+	}
+	return int(first)
+}
+
+// buildPageInfo describes a page of edges, which start and end at the
+// cursors of their first and last edge
+func buildPageInfo[E interface{ GetCursor() string }](edges []E, hasPreviousPage, hasNextPage bool) *pb.PageInfo {
+	pageInfo := &pb.PageInfo{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: hasPreviousPage,
+	}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = edges[0].GetCursor()
+		pageInfo.EndCursor = edges[len(edges)-1].GetCursor()
+	}
+	return pageInfo
+}
+
+// encodeCursor builds an opaque cursor for a (created_at, id) keyset position
+func encodeCursor(createdAt time.Time, id string) string {
+	raw := createdAt.UTC().Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor extracts the (created_at, id) keyset position from a cursor
+func decodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	return parseCursorPosition(string(raw))
+}
+
+// parseCursorPosition parses the created_at|id part of a decoded cursor
+func parseCursorPosition(raw string) (time.Time, string, error) {
+	createdAt, id, ok := strings.Cut(raw, "|")
+	if !ok || id == "" {
+		return time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	return t, id, nil
+}
+
+// encodeSearchCursor builds an opaque cursor for a (rank, created_at, id)
+// keyset position in ranked search results
+func encodeSearchCursor(rank float32, createdAt time.Time, id string) string {
+	raw := strconv.FormatFloat(float64(rank), 'g', -1, 32) + "|" + createdAt.UTC().Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeSearchCursor extracts the (rank, created_at, id) keyset position
+// from a search cursor
+func decodeSearchCursor(cursor string) (float32, time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	rankText, position, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return 0, time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	rank, err := strconv.ParseFloat(rankText, 32)
+	if err != nil {
+		return 0, time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	createdAt, id, err := parseCursorPosition(position)
+	if err != nil {
+		return 0, time.Time{}, "", err
+	}
+
+	return float32(rank), createdAt, id, nil
+}
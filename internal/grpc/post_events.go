@@ -0,0 +1,243 @@
+package grpc
+
+import (
+	"encoding/json"
+	"muze/internal/messaging"
+	pb "muze/proto"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// decodePostEvent converts a NATS post event into the message sent on
+// StreamPosts. Unknown subjects yield a nil event and no error.
+func (s *PostServer) decodePostEvent(subject string, data []byte) (*pb.PostEvent, error) {
+	switch subject {
+	case messaging.SubjectPostCreated:
+		var event messaging.PostCreatedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		var imageURL *wrapperspb.StringValue
+		if event.ImageURL != nil {
+			imageURL = wrapperspb.String(*event.ImageURL)
+		}
+		var quotedPostID, parentID, rootID string
+		if event.QuotedPostID != nil {
+			quotedPostID = *event.QuotedPostID
+		}
+		if event.ParentID != nil {
+			parentID = *event.ParentID
+		}
+		if event.RootID != nil {
+			rootID = *event.RootID
+		}
+
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_CREATED,
+			Post: &pb.Post{
+				Id:           event.PostID,
+				Content:      event.Content,
+				AuthorId:     event.AuthorID,
+				AuthorName:   event.AuthorName,
+				ImageUrl:     imageURL,
+				Media:        convertToProtoPostMedia(event.Media),
+				QuotedPostId: quotedPostID,
+				ParentId:     parentID,
+				RootId:       rootID,
+				Likes:        int32(event.Likes),
+				Timestamp:    event.Timestamp,
+			},
+			UserId: event.AuthorID,
+		}, nil
+
+	case messaging.SubjectPostLiked:
+		var event messaging.PostLikedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		// Like events only carry the post's identity and counters
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_LIKED,
+			Post: &pb.Post{
+				Id:        event.PostID,
+				AuthorId:  event.AuthorID,
+				Likes:     int32(event.Likes),
+				Timestamp: event.Timestamp,
+			},
+			UserId: event.UserID,
+		}, nil
+
+	case messaging.SubjectPostUnliked:
+		var event messaging.PostUnlikedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_UNLIKED,
+			Post: &pb.Post{
+				Id:        event.PostID,
+				AuthorId:  event.AuthorID,
+				Likes:     int32(event.Likes),
+				Timestamp: event.Timestamp,
+			},
+			UserId: event.UserID,
+		}, nil
+
+	case messaging.SubjectPostReacted:
+		var event messaging.PostReactedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		// Reaction events carry the post's counters per type
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_REACTED,
+			Post: &pb.Post{
+				Id:        event.PostID,
+				AuthorId:  event.AuthorID,
+				Likes:     int32(event.ReactionCounts[likeReaction]),
+				Reactions: convertToProtoReactions(s.reactionTypes, event.ReactionCounts),
+				Timestamp: event.Timestamp,
+			},
+			UserId:   event.UserID,
+			Reaction: event.Reaction,
+		}, nil
+
+	case messaging.SubjectPostUnreacted:
+		var event messaging.PostUnreactedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_UNREACTED,
+			Post: &pb.Post{
+				Id:        event.PostID,
+				AuthorId:  event.AuthorID,
+				Likes:     int32(event.ReactionCounts[likeReaction]),
+				Reactions: convertToProtoReactions(s.reactionTypes, event.ReactionCounts),
+				Timestamp: event.Timestamp,
+			},
+			UserId:   event.UserID,
+			Reaction: event.Reaction,
+		}, nil
+
+	case messaging.SubjectPostReposted:
+		var event messaging.PostRepostedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		// Repost events are about the shared post and carry its counter
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_REPOSTED,
+			Post: &pb.Post{
+				Id:          event.PostID,
+				AuthorId:    event.AuthorID,
+				RepostCount: int32(event.RepostCount),
+				Timestamp:   event.Timestamp,
+			},
+			UserId: event.UserID,
+		}, nil
+
+	case messaging.SubjectPostCommented:
+		var event messaging.PostCommentedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		// Comment events carry the post's new comment count
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_COMMENTED,
+			Post: &pb.Post{
+				Id:           event.PostID,
+				AuthorId:     event.AuthorID,
+				CommentCount: int32(event.CommentCount),
+				Timestamp:    event.Timestamp,
+			},
+			UserId: event.UserID,
+		}, nil
+
+	case messaging.SubjectPostUpdated:
+		var event messaging.PostUpdatedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		var imageURL *wrapperspb.StringValue
+		if event.ImageURL != nil {
+			imageURL = wrapperspb.String(*event.ImageURL)
+		}
+
+		// Update events carry the edited fields
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_UPDATED,
+			Post: &pb.Post{
+				Id:        event.PostID,
+				Content:   event.Content,
+				AuthorId:  event.AuthorID,
+				ImageUrl:  imageURL,
+				Media:     convertToProtoPostMedia(event.Media),
+				Timestamp: event.Timestamp,
+			},
+			UserId: event.AuthorID,
+		}, nil
+
+	case messaging.SubjectPostDeleted:
+		var event messaging.PostDeletedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		return &pb.PostEvent{
+			Type: pb.PostEventType_POST_EVENT_TYPE_DELETED,
+			Post: &pb.Post{
+				Id:        event.PostID,
+				AuthorId:  event.AuthorID,
+				Timestamp: event.Timestamp,
+			},
+			UserId: event.DeletedBy,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// postEventFilter applies the StreamPostsRequest filters. Each non-empty
+// filter must match for an event to be sent.
+type postEventFilter struct {
+	authorIDs map[string]bool
+	postIDs   map[string]bool
+}
+
+func newPostEventFilter(req *pb.StreamPostsRequest) postEventFilter {
+	return postEventFilter{
+		authorIDs: toSet(req.AuthorIds),
+		postIDs:   toSet(req.PostIds),
+	}
+}
+
+func (f postEventFilter) matches(event *pb.PostEvent) bool {
+	if len(f.authorIDs) > 0 && !f.authorIDs[event.Post.GetAuthorId()] {
+		return false
+	}
+	if len(f.postIDs) > 0 && !f.postIDs[event.Post.GetId()] {
+		return false
+	}
+	return true
+}
+
+func toSet(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
@@ -3,65 +3,143 @@ package grpc
 import (
 	"context"
 	"log"
+	"muze/internal/auth"
 	"muze/internal/cache"
 	"muze/internal/database"
 	"muze/internal/messaging"
 	"muze/internal/models"
+	"muze/internal/storage"
 	pb "muze/proto"
+	"os"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostServer struct {
 	pb.UnimplementedPostServiceServer
-	db *gorm.DB
+	db      *gorm.DB
+	storage storage.Storage
+	filters []PostFilter
+
+	// reactionTypes are the configured reactions in display order
+	reactionTypes []reactionType
 }
 
 func NewPostServer() *PostServer {
 	return &PostServer{
-		db: database.DB,
+		db:            database.DB,
+		storage:       storage.Store,
+		filters:       defaultPostFilters(database.DB),
+		reactionTypes: parseReactionTypes(os.Getenv("REACTIONS")),
 	}
 }
 
 // Helper function to convert Go model to protobuf Post
-func convertToProtoPost(post models.Post) *pb.Post {
+func (s *PostServer) convertToProtoPost(post models.Post) *pb.Post {
 	var imageURL *wrapperspb.StringValue
 	if post.ImageURL != nil {
 		imageURL = wrapperspb.String(*post.ImageURL)
 	}
 
+	var repostOfID, quotedPostID, parentID, rootID string
+	if post.RepostOfID != nil {
+		repostOfID = *post.RepostOfID
+	}
+	if post.QuotedPostID != nil {
+		quotedPostID = *post.QuotedPostID
+	}
+	if post.ParentID != nil {
+		parentID = *post.ParentID
+	}
+	if post.RootID != nil {
+		rootID = *post.RootID
+	}
+
 	return &pb.Post{
-		Id:         post.ID,
-		Content:    post.Content,
-		AuthorId:   post.AuthorID,
-		AuthorName: post.AuthorName,
-		ImageUrl:   imageURL,
-		Likes:      int32(post.Likes),
-		Timestamp:  post.CreatedAt.Format(time.RFC3339),
+		Id:           post.ID,
+		Content:      post.Content,
+		AuthorId:     post.AuthorID,
+		AuthorName:   post.AuthorName,
+		ImageUrl:     imageURL,
+		Likes:        int32(post.Likes),
+		Timestamp:    post.CreatedAt.Format(time.RFC3339),
+		CommentCount: int32(post.CommentCount),
+		Entities:     convertToProtoEntities(post.Entities),
+		Media:        convertToProtoPostMedia(post.Media),
+		Reactions:    convertToProtoReactions(s.reactionTypes, post.ReactionCounts),
+		RepostOfId:   repostOfID,
+		QuotedPostId: quotedPostID,
+		RepostCount:  int32(post.RepostCount),
+		ParentId:     parentID,
+		RootId:       rootID,
+		ReplyCount:   int32(post.ReplyCount),
 	}
 }
 
+// updatePostCounter moves one of a post's counters by delta atomically so
+// concurrent updates never overwrite each other. Counters never drop
+// below zero.
+func updatePostCounter(tx *gorm.DB, postID, column string, delta int) error {
+	return tx.Model(&models.Post{}).Where("id = ?", postID).UpdateColumns(map[string]interface{}{
+		column:       gorm.Expr("GREATEST(? + ?, 0)", clause.Column{Name: column}, delta),
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// visiblePosts leaves out posts hidden by moderators. Reads that serve
+// posts to users go through it; hidden posts are never cached.
+func visiblePosts(db *gorm.DB) *gorm.DB {
+	return db.Where("posts.hidden_at IS NULL")
+}
+
+// expandPosts fills in what is not stored with each post: the posts that
+// reposts and quotes share and the caller's own reactions and bookmarks
+func (s *PostServer) expandPosts(ctx context.Context, posts ...*pb.Post) {
+	all := append(s.withSharedPosts(posts), posts...)
+	s.withViewerReactions(ctx, all...)
+	s.withViewerBookmarks(ctx, all...)
+}
+
+// expandPage is expandPosts for a page of posts
+func (s *PostServer) expandPage(ctx context.Context, resp *pb.GetPostsResponse) *pb.GetPostsResponse {
+	posts := make([]*pb.Post, 0, len(resp.Posts)+len(resp.Edges))
+	posts = append(posts, resp.Posts...)
+	for _, edge := range resp.Edges {
+		posts = append(posts, edge.Post)
+	}
+	s.expandPosts(ctx, posts...)
+	return resp
+}
+
 func (s *PostServer) GetPosts(ctx context.Context, req *pb.GetPostsRequest) (*pb.GetPostsResponse, error) {
+	// Cursor paging skips the full count
+	if req.First > 0 || req.After != "" {
+		resp, err := s.getPostsPage(req)
+		if err != nil {
+			return nil, err
+		}
+		return s.expandPage(ctx, resp), nil
+	}
+
 	// Try cache first
-	cachedPosts, err := cache.GetRecentPosts()
-	if err == nil && len(cachedPosts) > 0 {
-		// Convert to protobuf format
+	if cachedPosts, total, ok := s.getFeedFromCache(int(req.Offset), int(req.Limit)); ok {
 		var pbPosts []*pb.Post
 		for _, post := range cachedPosts {
-			pbPosts = append(pbPosts, convertToProtoPost(post))
+			pbPosts = append(pbPosts, s.convertToProtoPost(post))
 		}
-		return &pb.GetPostsResponse{Posts: pbPosts, Total: int32(len(pbPosts))}, nil
+		return s.expandPage(ctx, &pb.GetPostsResponse{Posts: pbPosts, Total: int32(total)}), nil
 	}
 
 	// If not in cache, get from database
 	var posts []models.Post
 	var total int64
 
-	query := s.db.Model(&models.Post{})
+	query := s.db.Model(&models.Post{}).Scopes(visiblePosts)
 	query.Count(&total)
 
 	if req.Limit > 0 {
@@ -71,77 +149,248 @@ func (s *PostServer) GetPosts(ctx context.Context, req *pb.GetPostsRequest) (*pb
 		query = query.Offset(int(req.Offset))
 	}
 
-	err = query.Order("created_at DESC").Find(&posts).Error
+	err := query.Order("created_at DESC, id DESC").Find(&posts).Error
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get posts: %v", err)
 	}
 
-	// Cache the results (exactly 10 most recent posts)
-	if len(posts) > 0 {
-		cachePosts := posts
-		if len(posts) > 10 {
-			cachePosts = posts[:10]
+	// Convert to protobuf format
+	var pbPosts []*pb.Post
+	for _, post := range posts {
+		pbPosts = append(pbPosts, s.convertToProtoPost(post))
+	}
+
+	return s.expandPage(ctx, &pb.GetPostsResponse{Posts: pbPosts, Total: int32(total)}), nil
+}
+
+// getPostsPage returns the page of posts after the request cursor using
+// keyset pagination on (created_at, id)
+func (s *PostServer) getPostsPage(req *pb.GetPostsRequest) (*pb.GetPostsResponse, error) {
+	limit := pageSize(req.First)
+
+	// The head of the feed is served from the cached window
+	if req.After == "" {
+		if posts, _, ok := s.getFeedFromCache(0, limit+1); ok {
+			hasNextPage := len(posts) > limit
+			if hasNextPage {
+				posts = posts[:limit]
+			}
+			return s.buildPostConnection(posts, false, hasNextPage), nil
 		}
-		cache.CacheRecentPosts(cachePosts)
 	}
 
-	// Convert to protobuf format
-	var pbPosts []*pb.Post
+	return s.queryPostsPage(s.db.Model(&models.Post{}).Scopes(visiblePosts), req.After, limit)
+}
+
+// queryPostsPage returns the page of posts matched by query that comes
+// after the cursor, newest first
+func (s *PostServer) queryPostsPage(query *gorm.DB, after string, limit int) (*pb.GetPostsResponse, error) {
+	if after != "" {
+		createdAt, id, err := decodeCursor(after)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(posts.created_at, posts.id) < (?, ?)", createdAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var posts []models.Post
+	err := query.Order("posts.created_at DESC, posts.id DESC").Limit(limit + 1).Find(&posts).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get posts: %v", err)
+	}
+
+	hasNextPage := len(posts) > limit
+	if hasNextPage {
+		posts = posts[:limit]
+	}
+
+	return s.buildPostConnection(posts, after != "", hasNextPage), nil
+}
+
+// buildPostConnection wraps a page of posts in edges with cursors
+func (s *PostServer) buildPostConnection(posts []models.Post, hasPreviousPage, hasNextPage bool) *pb.GetPostsResponse {
+	edges := make([]*pb.PostEdge, 0, len(posts))
 	for _, post := range posts {
-		pbPosts = append(pbPosts, convertToProtoPost(post))
+		edges = append(edges, &pb.PostEdge{
+			Post:   s.convertToProtoPost(post),
+			Cursor: encodeCursor(post.CreatedAt, post.ID),
+		})
 	}
 
-	return &pb.GetPostsResponse{Posts: pbPosts, Total: int32(total)}, nil
+	pageInfo := buildPageInfo(edges, hasPreviousPage, hasNextPage)
+
+	return &pb.GetPostsResponse{Edges: edges, PageInfo: pageInfo}
 }
 
 func (s *PostServer) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb.Post, error) {
+	// Posts are always written as the authenticated caller
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.AuthorId != "" && req.AuthorId != claims.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot post as another user")
+	}
+
 	// Validate input
 	if req.Content == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "content cannot be empty")
 	}
 
-	// Convert ImageUrl from protobuf to Go model
-	var modelImageURL *string
-	if req.ImageUrl != nil {
-		modelImageURL = &req.ImageUrl.Value
+	// Attachments are resolved up front so invalid media fails early
+	attachments, err := s.postAttachments(claims.UserID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNotSuspended(claims.UserID); err != nil {
+		return nil, err
+	}
+
+	// Filters reject the post or flag it for moderators
+	flags, err := s.filterPost(ctx, PostCandidate{
+		AuthorID:     claims.UserID,
+		Content:      req.Content,
+		ParentID:     req.ParentId,
+		QuotedPostID: req.QuotedPostId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var quotedPostID *string
+	if req.QuotedPostId != "" {
+		quoted, err := findSharedPost(s.db, req.QuotedPostId)
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "quoted post not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		quotedPostID = &quoted.ID
+	}
+
+	// Replies remember their parent and the post that started the thread.
+	// Like quotes, replies to a repost answer the post it shares.
+	var parentID, rootID *string
+	if req.ParentId != "" {
+		parent, err := findSharedPost(s.db, req.ParentId)
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "parent post not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		parentID = &parent.ID
+		rootID = parent.RootID
+		if rootID == nil {
+			rootID = &parent.ID
+		}
 	}
 
-	// Create post
+	// Create post, truncating to the microsecond precision Postgres stores
+	// so cached feed ordering matches the database
+	now := time.Now().Truncate(time.Microsecond)
 	post := models.Post{
-		Content:    req.Content,
-		AuthorID:   req.AuthorId,
-		AuthorName: "User " + req.AuthorId, // In real app, get from user service
-		ImageURL:   modelImageURL,
-		Likes:      0,
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+		Content:      req.Content,
+		AuthorID:     claims.UserID,
+		AuthorName:   s.authorName(claims),
+		Likes:        0,
+		QuotedPostID: quotedPostID,
+		ParentID:     parentID,
+		RootID:       rootID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
 	}
 
-	err := s.db.Create(&post).Error
+	// Hashtags and mentions are stored with the post for rendering and in
+	// post_tags and post_mentions for lookups
+	post.Entities, err = s.resolveEntities(post.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve mentions: %v", err)
+	}
+
+	var mentioned []string
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&post).Error; err != nil {
+			return err
+		}
+		if len(attachments) > 0 {
+			if err := savePostMedia(tx, &post, attachments); err != nil {
+				return err
+			}
+		}
+		if parentID != nil {
+			if err := updatePostCounter(tx, *parentID, "reply_count", 1); err != nil {
+				return err
+			}
+		}
+		if len(flags) > 0 {
+			if err := flagPost(tx, post.ID, flags); err != nil {
+				return err
+			}
+		}
+		var err error
+		mentioned, err = saveEntities(tx, post.ID, post.Entities)
+		return err
+	})
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create post: %v", err)
 	}
 
-	// Cache the post
+	// Cache the post and add it to the head of the feed, and refresh the
+	// parent's reply counter
 	cache.CachePost(post)
+	cache.AddToFeed(post)
+	if parentID != nil {
+		cache.InvalidatePostCache(*parentID)
+	}
 
 	// Publish to NATS for real-time updates
 	messaging.PublishPostCreated(post)
+	publishMentions(post, mentioned)
 
 	// Return response
-	return convertToProtoPost(post), nil
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+// authorName returns the display name stored with a new post. Readers
+// resolve the current name from the users table; this is the fallback for
+// authors without a profile.
+func (s *PostServer) authorName(claims *auth.Claims) string {
+	if isUUID(claims.UserID) {
+		var user models.User
+		if err := s.db.Select("name").Where("id = ?", claims.UserID).First(&user).Error; err == nil {
+			return user.Name
+		}
+	}
+	if claims.Name != "" {
+		return claims.Name
+	}
+	return "User " + claims.UserID
 }
 
 func (s *PostServer) GetPostById(ctx context.Context, req *pb.GetPostByIdRequest) (*pb.Post, error) {
+	if !isUUID(req.Id) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
 	// Try cache first
 	cachedPost, err := cache.GetCachedPost(req.Id)
 	if err == nil {
-		return convertToProtoPost(*cachedPost), nil
+		pbPost := s.convertToProtoPost(*cachedPost)
+		s.expandPosts(ctx, pbPost)
+		return pbPost, nil
 	}
 
 	// If not in cache, get from database
 	var post models.Post
-	err = s.db.Where("id = ?", req.Id).First(&post).Error
+	err = s.db.Scopes(visiblePosts).Where("id = ?", req.Id).First(&post).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Errorf(codes.NotFound, "post not found")
@@ -152,74 +401,257 @@ func (s *PostServer) GetPostById(ctx context.Context, req *pb.GetPostByIdRequest
 	// Cache the post
 	cache.CachePost(post)
 
-	return convertToProtoPost(post), nil
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
 }
 
 func (s *PostServer) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.Post, error) {
-	// Check if user already liked the post
-	var existingLike models.PostLike
-	err := s.db.Where("post_id = ? AND user_id = ?", req.PostId, req.UserId).First(&existingLike).Error
-	if err == nil {
-		return nil, status.Errorf(codes.AlreadyExists, "user already liked this post")
+	// Likes are always recorded for the authenticated caller
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId != "" && req.UserId != claims.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot like as another user")
 	}
 
-	// Start transaction
-	tx := s.db.Begin()
+	post, err := s.addReaction(req.PostId, claims.UserID, likeReaction)
+	if err != nil {
+		return nil, err
+	}
 
-	// Create like record
-	like := models.PostLike{
-		PostID: req.PostId,
-		UserID: req.UserId,
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+func (s *PostServer) UnlikePost(ctx context.Context, req *pb.UnlikePostRequest) (*pb.Post, error) {
+	// Likes are always removed for the authenticated caller
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId != "" && req.UserId != claims.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot unlike as another user")
 	}
-	err = tx.Create(&like).Error
+
+	post, err := s.removeReaction(req.PostId, claims.UserID, likeReaction)
 	if err != nil {
-		tx.Rollback()
-		return nil, status.Errorf(codes.Internal, "failed to create like: %v", err)
+		return nil, err
 	}
 
-	// Update post likes count
-	var post models.Post
-	err = tx.Where("id = ?", req.PostId).First(&post).Error
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+func (s *PostServer) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest) (*pb.Post, error) {
+	claims, err := requireUser(ctx)
 	if err != nil {
-		tx.Rollback()
+		return nil, err
+	}
+
+	// Validate input
+	if req.Content != nil && req.Content.Value == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content cannot be empty")
+	}
+	if err := validateImageURL(req.ImageUrl.GetValue()); err != nil {
+		return nil, err
+	}
+	if !isUUID(req.Id) {
 		return nil, status.Errorf(codes.NotFound, "post not found")
 	}
+	if err := s.checkNotSuspended(claims.UserID); err != nil {
+		return nil, err
+	}
 
-	post.Likes++
-	post.UpdatedAt = time.Now()
-	err = tx.Save(&post).Error
+	var post models.Post
+	err = s.db.Scopes(visiblePosts).Where("id = ?", req.Id).First(&post).Error
 	if err != nil {
-		tx.Rollback()
-		return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+
+	// Only the author can edit a post
+	if post.AuthorID != claims.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "only the author can edit this post")
+	}
+	if post.RepostOfID != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "reposts cannot be edited")
+	}
+
+	var columns, flags []string
+	if req.Content != nil {
+		// Edits go through the same filters as new posts
+		flags, err = s.filterPost(ctx, PostCandidate{
+			PostID:   post.ID,
+			AuthorID: claims.UserID,
+			Content:  req.Content.Value,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		post.Content = req.Content.Value
+		post.Entities, err = s.resolveEntities(post.Content)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve mentions: %v", err)
+		}
+		columns = append(columns, "content", "entities")
 	}
+	if len(columns) > 0 || req.ImageUrl != nil {
+		if err := s.savePostUpdate(&post, columns, flags, req); err != nil {
+			return nil, err
+		}
+	}
+
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+// savePostUpdate writes an edit to the given columns, replaces the post's
+// attachments when the request sets a new image URL, queues the post for
+// moderators when filters flagged the edit, and tells caches and
+// subscribers about it
+func (s *PostServer) savePostUpdate(post *models.Post, columns, flags []string, req *pb.UpdatePostRequest) error {
+	post.UpdatedAt = time.Now().Truncate(time.Microsecond)
+	columns = append(columns, "updated_at")
+
+	var mentioned []string
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(post).Select(columns).Updates(post).Error; err != nil {
+			return err
+		}
 
-	// Commit transaction
-	err = tx.Commit().Error
+		// A new image URL replaces all attachments
+		if req.ImageUrl != nil {
+			if err := savePostMedia(tx, post, imageURLAttachments(req.ImageUrl.Value)); err != nil {
+				return err
+			}
+		}
+		if len(flags) > 0 {
+			if err := flagPost(tx, post.ID, flags); err != nil {
+				return err
+			}
+		}
+		if req.Content == nil {
+			return nil
+		}
+		var err error
+		mentioned, err = saveEntities(tx, post.ID, post.Entities)
+		return err
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+		return status.Errorf(codes.Internal, "failed to update post: %v", err)
 	}
 
-	// Invalidate cache
-	cache.InvalidatePostCache(req.PostId)
+	// Refresh the cached post; the feed window only holds its ID
+	cache.CachePost(*post)
 
 	// Publish to NATS for real-time updates
-	messaging.PublishPostLiked(post, req.UserId)
+	messaging.PublishPostUpdated(*post)
+	publishMentions(*post, mentioned)
+	return nil
+}
+
+func (s *PostServer) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isUUID(req.Id) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
+	var post models.Post
+	err = s.db.Where("id = ?", req.Id).First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+
+	// Authors can delete their own posts, admins can delete any post
+	if post.AuthorID != claims.UserID && !claims.IsAdmin() {
+		return nil, status.Errorf(codes.PermissionDenied, "only the author or an admin can delete this post")
+	}
 
-	return convertToProtoPost(post), nil
+	var reposts []models.Post
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		reposts, err = deletePost(tx, post)
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete post: %v", err)
+	}
+
+	forgetRemovedPost(post, reposts, claims.UserID)
+
+	return &pb.DeletePostResponse{Id: post.ID}, nil
+}
+
+// deletePost soft deletes a post with its reposts, which it returns, and
+// updates the counters of the post it replies to or reposts
+func deletePost(tx *gorm.DB, post models.Post) ([]models.Post, error) {
+	// Soft delete through gorm's DeletedAt column
+	if err := tx.Delete(&post).Error; err != nil {
+		return nil, err
+	}
+
+	// Deleted replies and reposts stop counting, and reposts go with their
+	// post. Hidden replies and reposts stopped counting when they were
+	// hidden.
+	if post.ParentID != nil && post.HiddenAt == nil {
+		if err := updatePostCounter(tx, *post.ParentID, "reply_count", -1); err != nil {
+			return nil, err
+		}
+	}
+	if post.RepostOfID != nil {
+		if post.HiddenAt != nil {
+			return nil, nil
+		}
+		return nil, updatePostCounter(tx, *post.RepostOfID, "repost_count", -1)
+	}
+	return deleteReposts(tx, post.ID)
+}
+
+// forgetRemovedPost drops a deleted or hidden post and its reposts from
+// the caches, tells subscribers and refreshes the counters of the post it
+// replies to or reposts
+func forgetRemovedPost(post models.Post, reposts []models.Post, removedBy string) {
+	forgetDeletedPost(post, removedBy)
+	for _, repost := range reposts {
+		forgetDeletedPost(repost, removedBy)
+	}
+	if post.ParentID != nil {
+		cache.InvalidatePostCache(*post.ParentID)
+	}
+	if post.RepostOfID != nil {
+		cache.InvalidatePostCache(*post.RepostOfID)
+	}
 }
 
 func (s *PostServer) StreamPosts(req *pb.StreamPostsRequest, stream pb.PostService_StreamPostsServer) error {
+	filter := newPostEventFilter(req)
+
 	// Subscribe to NATS events
-	subscription, err := messaging.SubscribeToPosts(func(data []byte) {
-		// Parse the event and send to stream
-		// This is a simplified version - in real app, you'd parse the event properly
-		post := &pb.Post{
-			Id:        "streamed-post",
-			Content:   "Real-time post update",
-			Timestamp: time.Now().Format(time.RFC3339),
+	subscription, err := messaging.SubscribeToPostEvents(func(subject string, data []byte) {
+		event, err := s.decodePostEvent(subject, data)
+		if err != nil {
+			log.Printf("Failed to decode %s event: %v", subject, err)
+			return
+		}
+		if event == nil || !filter.matches(event) {
+			return
 		}
 
-		if err := stream.Send(post); err != nil {
+		if err := stream.Send(event); err != nil {
 			log.Printf("Failed to send stream post: %v", err)
 		}
 	})
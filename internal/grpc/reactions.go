@@ -0,0 +1,306 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"muze/internal/auth"
+	"muze/internal/cache"
+	"muze/internal/messaging"
+	"muze/internal/models"
+	pb "muze/proto"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// likeReaction is the reaction LikePost and UnlikePost record. It is always
+// available and kept in sync with the post's likes counter.
+const likeReaction = "like"
+
+// defaultReactions is used when REACTIONS is unset. Entries are type:emoji
+// pairs in display order.
+const defaultReactions = "like:👍,love:❤️,haha:😂,wow:😮,sad:😢,angry:😡"
+
+type reactionType struct {
+	Type  string
+	Emoji string
+}
+
+// parseReactionTypes returns the reactions configured by a REACTIONS
+// setting in display order. Types are lowercased and "like" is added first
+// when the setting leaves it out.
+func parseReactionTypes(config string) []reactionType {
+	if config == "" {
+		config = defaultReactions
+	}
+
+	var types []reactionType
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(config, ",") {
+		name, emoji, _ := strings.Cut(entry, ":")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		types = append(types, reactionType{Type: name, Emoji: strings.TrimSpace(emoji)})
+	}
+
+	if !seen[likeReaction] {
+		types = append([]reactionType{{Type: likeReaction, Emoji: "👍"}}, types...)
+	}
+	return types
+}
+
+// validReactionType normalizes a requested reaction type and reports
+// whether it is configured
+func (s *PostServer) validReactionType(value string) (string, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, t := range s.reactionTypes {
+		if t.Type == value {
+			return value, true
+		}
+	}
+	return value, false
+}
+
+// Helper function to convert reaction counters to protobuf. Types that are
+// no longer configured and zero counts are left out.
+func convertToProtoReactions(types []reactionType, counts map[string]int) []*pb.ReactionCount {
+	var result []*pb.ReactionCount
+	for _, t := range types {
+		if counts[t.Type] > 0 {
+			result = append(result, &pb.ReactionCount{
+				Type:  t.Type,
+				Emoji: t.Emoji,
+				Count: int32(counts[t.Type]),
+			})
+		}
+	}
+	return result
+}
+
+// reactionCountUpdate returns the column updates that move a post's
+// counter for reaction by delta. Counters never drop below zero.
+func reactionCountUpdate(reaction string, delta int) map[string]interface{} {
+	updates := map[string]interface{}{
+		"reaction_counts": gorm.Expr(`jsonb_set(
+			COALESCE(NULLIF(reaction_counts, 'null'::jsonb), '{}'::jsonb), ARRAY[?]::text[],
+			to_jsonb(GREATEST(COALESCE((reaction_counts->>?)::int, 0) + ?, 0)))`, reaction, reaction, delta),
+		"updated_at": time.Now(),
+	}
+	if reaction == likeReaction {
+		updates["likes"] = gorm.Expr("GREATEST(likes + ?, 0)", delta)
+	}
+	return updates
+}
+
+// addReaction records the user's reaction on a post and returns the post
+// with its updated counters
+func (s *PostServer) addReaction(postID, userID, reaction string) (models.Post, error) {
+	var post models.Post
+	if !isUUID(postID) {
+		return post, status.Errorf(codes.NotFound, "post not found")
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Make sure the post exists before recording the reaction
+		if err := tx.Scopes(visiblePosts).Where("id = ?", postID).First(&post).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Errorf(codes.NotFound, "post not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get post: %v", err)
+		}
+
+		// The unique (post_id, user_id, type) index rejects a second
+		// reaction of the same type even when two requests race
+		record := models.PostReaction{
+			PostID: postID,
+			UserID: userID,
+			Type:   reaction,
+		}
+		if err := tx.Create(&record).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				if reaction == likeReaction {
+					return status.Errorf(codes.AlreadyExists, "user already liked this post")
+				}
+				return status.Errorf(codes.AlreadyExists, "user already reacted with %q to this post", reaction)
+			}
+			return status.Errorf(codes.Internal, "failed to create reaction: %v", err)
+		}
+
+		// Update the counters atomically so concurrent reactions never
+		// overwrite each other
+		if err := tx.Model(&post).UpdateColumns(reactionCountUpdate(reaction, 1)).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to update post: %v", err)
+		}
+
+		// Reload the counters written by the database
+		return tx.Where("id = ?", postID).First(&post).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return post, err
+		}
+		return post, status.Errorf(codes.Internal, "failed to add reaction: %v", err)
+	}
+
+	// Invalidate cache
+	cache.InvalidatePostCache(postID)
+
+	// Publish to NATS for real-time updates
+	messaging.PublishPostReacted(post, userID, reaction)
+	if reaction == likeReaction {
+		messaging.PublishPostLiked(post, userID)
+	}
+
+	return post, nil
+}
+
+// removeReaction deletes the user's reaction from a post and returns the
+// post with its updated counters. Removing a missing reaction is a no-op,
+// so repeated requests never drive a counter below its reactions. Like
+// adding, it is refused on deleted and hidden posts.
+func (s *PostServer) removeReaction(postID, userID, reaction string) (models.Post, error) {
+	var post models.Post
+	if !isUUID(postID) {
+		return post, status.Errorf(codes.NotFound, "post not found")
+	}
+	removed := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Scopes(visiblePosts).Where("id = ?", postID).First(&post).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Errorf(codes.NotFound, "post not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get post: %v", err)
+		}
+
+		result := tx.Where("post_id = ? AND user_id = ? AND type = ?", postID, userID, reaction).Delete(&models.PostReaction{})
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "failed to delete reaction: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		removed = true
+
+		if err := tx.Model(&post).UpdateColumns(reactionCountUpdate(reaction, -1)).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to update post: %v", err)
+		}
+
+		// Reload the counters written by the database
+		return tx.Where("id = ?", postID).First(&post).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return post, err
+		}
+		return post, status.Errorf(codes.Internal, "failed to remove reaction: %v", err)
+	}
+
+	if removed {
+		// Invalidate cache
+		cache.InvalidatePostCache(postID)
+
+		// Publish to NATS for real-time updates
+		messaging.PublishPostUnreacted(post, userID, reaction)
+		if reaction == likeReaction {
+			messaging.PublishPostUnliked(post, userID)
+		}
+	}
+
+	return post, nil
+}
+
+// AddReaction adds the caller's reaction of the requested type to a post
+func (s *PostServer) AddReaction(ctx context.Context, req *pb.ReactionRequest) (*pb.Post, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction, ok := s.validReactionType(req.Type)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown reaction type %q", req.Type)
+	}
+
+	post, err := s.addReaction(req.PostId, claims.UserID, reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+// RemoveReaction removes the caller's reaction of the requested type from
+// a post
+func (s *PostServer) RemoveReaction(ctx context.Context, req *pb.ReactionRequest) (*pb.Post, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction, ok := s.validReactionType(req.Type)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown reaction type %q", req.Type)
+	}
+
+	post, err := s.removeReaction(req.PostId, claims.UserID, reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	pbPost := s.convertToProtoPost(post)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+// ListReactionTypes returns the configured reactions in display order
+func (s *PostServer) ListReactionTypes(ctx context.Context, req *pb.ListReactionTypesRequest) (*pb.ListReactionTypesResponse, error) {
+	var types []*pb.ReactionType
+	for _, t := range s.reactionTypes {
+		types = append(types, &pb.ReactionType{Type: t.Type, Emoji: t.Emoji})
+	}
+	return &pb.ListReactionTypesResponse{Types: types}, nil
+}
+
+// withViewerReactions fills in viewer_reactions for the authenticated
+// caller. Anonymous calls and posts without reactions are left as they are.
+func (s *PostServer) withViewerReactions(ctx context.Context, posts ...*pb.Post) {
+	claims, err := auth.ExtractUserFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	var postIDs []string
+	for _, post := range posts {
+		if len(post.Reactions) > 0 {
+			postIDs = append(postIDs, post.Id)
+		}
+	}
+	if len(postIDs) == 0 {
+		return
+	}
+
+	var reactions []models.PostReaction
+	err = s.db.Where("user_id = ? AND post_id IN ?", claims.UserID, postIDs).
+		Order("created_at, id").
+		Find(&reactions).Error
+	if err != nil {
+		log.Printf("Failed to load viewer reactions: %v", err)
+		return
+	}
+
+	byPost := make(map[string][]string)
+	for _, reaction := range reactions {
+		byPost[reaction.PostID] = append(byPost[reaction.PostID], reaction.Type)
+	}
+	for _, post := range posts {
+		post.ViewerReactions = byPost[post.Id]
+	}
+}
@@ -0,0 +1,216 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"muze/internal/cache"
+	"muze/internal/messaging"
+	"muze/internal/models"
+	pb "muze/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// findSharedPost loads the post that a repost or quote of postID shares.
+// Reposts stand for the post they share, so sharing never nests them.
+func findSharedPost(tx *gorm.DB, postID string) (models.Post, error) {
+	var post models.Post
+	if !isUUID(postID) {
+		return post, status.Errorf(codes.NotFound, "post not found")
+	}
+	if err := tx.Scopes(visiblePosts).Where("id = ?", postID).First(&post).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return post, status.Errorf(codes.NotFound, "post not found")
+		}
+		return post, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+	if post.RepostOfID == nil {
+		return post, nil
+	}
+	return findSharedPost(tx, *post.RepostOfID)
+}
+
+// deleteReposts soft deletes the reposts of a deleted post and returns them
+func deleteReposts(tx *gorm.DB, postID string) ([]models.Post, error) {
+	var reposts []models.Post
+	if err := tx.Where("repost_of_id = ?", postID).Find(&reposts).Error; err != nil {
+		return nil, err
+	}
+	if len(reposts) == 0 {
+		return nil, nil
+	}
+	return reposts, tx.Where("repost_of_id = ?", postID).Delete(&models.Post{}).Error
+}
+
+// forgetDeletedPost drops a deleted post from the caches and tells
+// subscribers, which also removes it from timelines
+func forgetDeletedPost(post models.Post, deletedBy string) {
+	cache.InvalidatePostCache(post.ID)
+	cache.RemoveFromFeed(post.ID)
+	messaging.PublishPostDeleted(post, deletedBy)
+}
+
+// withSharedPosts embeds the posts that reposts and quotes share and
+// returns them. Shared posts that were deleted are left out.
+func (s *PostServer) withSharedPosts(posts []*pb.Post) []*pb.Post {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, post := range posts {
+		for _, id := range []string{post.RepostOfId, post.QuotedPostId} {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	loaded, err := s.loadPosts(ids)
+	if err != nil {
+		log.Printf("Failed to load shared posts: %v", err)
+		return nil
+	}
+
+	shared := make([]*pb.Post, 0, len(loaded))
+	byID := make(map[string]*pb.Post, len(loaded))
+	for _, post := range loaded {
+		pbPost := s.convertToProtoPost(post)
+		shared = append(shared, pbPost)
+		byID[post.ID] = pbPost
+	}
+	for _, post := range posts {
+		post.RepostOf = byID[post.RepostOfId]
+		post.QuotedPost = byID[post.QuotedPostId]
+	}
+	return shared
+}
+
+// Repost shares a post with the caller's followers. The repost is a post
+// of the caller's without content and is returned with the shared post.
+func (s *PostServer) Repost(ctx context.Context, req *pb.RepostRequest) (*pb.Post, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNotSuspended(claims.UserID); err != nil {
+		return nil, err
+	}
+
+	// Reposts are ordered with other posts, so use the precision Postgres
+	// stores like CreatePost does
+	now := time.Now().Truncate(time.Microsecond)
+	repost := models.Post{
+		AuthorID:   claims.UserID,
+		AuthorName: s.authorName(claims),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	var original models.Post
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		original, err = findSharedPost(tx, req.PostId)
+		if err != nil {
+			return err
+		}
+
+		// The partial unique index on (author_id, repost_of_id) rejects a
+		// second repost even when two requests race
+		repost.RepostOfID = &original.ID
+		if err := tx.Create(&repost).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return status.Errorf(codes.AlreadyExists, "user already reposted this post")
+			}
+			return status.Errorf(codes.Internal, "failed to create repost: %v", err)
+		}
+
+		if err := updatePostCounter(tx, original.ID, "repost_count", 1); err != nil {
+			return status.Errorf(codes.Internal, "failed to update post: %v", err)
+		}
+
+		// Reload the counter written by the database
+		return tx.Where("id = ?", original.ID).First(&original).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to repost: %v", err)
+	}
+
+	// Cache the repost, add it to the head of the feed and refresh the
+	// shared post's counter
+	cache.CachePost(repost)
+	cache.AddToFeed(repost)
+	cache.InvalidatePostCache(original.ID)
+
+	// Publish to NATS; the timeline fan-out pushes the repost to followers
+	messaging.PublishPostReposted(original, repost)
+
+	pbPost := s.convertToProtoPost(repost)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
+
+// UndoRepost deletes the caller's repost of a post and returns the shared
+// post. Undoing a repost that does not exist is a no-op.
+func (s *PostServer) UndoRepost(ctx context.Context, req *pb.RepostRequest) (*pb.Post, error) {
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var original, repost models.Post
+	removed := false
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		original, err = findSharedPost(tx, req.PostId)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("author_id = ? AND repost_of_id = ?", claims.UserID, original.ID).First(&repost).Error
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get repost: %v", err)
+		}
+
+		if err := tx.Delete(&repost).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to delete repost: %v", err)
+		}
+		removed = true
+
+		// A hidden repost stopped counting when it was hidden
+		if repost.HiddenAt == nil {
+			if err := updatePostCounter(tx, original.ID, "repost_count", -1); err != nil {
+				return status.Errorf(codes.Internal, "failed to update post: %v", err)
+			}
+		}
+
+		// Reload the counter written by the database
+		return tx.Where("id = ?", original.ID).First(&original).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to undo repost: %v", err)
+	}
+
+	if removed {
+		forgetDeletedPost(repost, claims.UserID)
+		cache.InvalidatePostCache(original.ID)
+	}
+
+	pbPost := s.convertToProtoPost(original)
+	s.expandPosts(ctx, pbPost)
+	return pbPost, nil
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"html"
+	"muze/internal/models"
+	pb "muze/proto"
+	"strings"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxSearchQueryLength bounds the raw search query in bytes
+	maxSearchQueryLength = 256
+
+	// Matches are delimited with private-use characters so the snippet can
+	// be escaped before the <mark> tags are added
+	headlineStart   = "\ue000"
+	headlineStop    = "\ue001"
+	headlineOptions = `StartSel="` + headlineStart + `", StopSel="` + headlineStop + `", MaxWords=35, MinWords=15, MaxFragments=2`
+)
+
+// searchResult is a post matched by SearchPosts with its rank and snippet
+type searchResult struct {
+	models.Post `gorm:"embedded"`
+	Rank        float32
+	Snippet     string
+}
+
+// SearchPosts runs a full-text search over post content, best matches first
+func (s *PostServer) SearchPosts(ctx context.Context, req *pb.SearchPostsRequest) (*pb.SearchPostsResponse, error) {
+	// Validate input
+	if len(req.Query) > maxSearchQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query cannot be longer than %d bytes", maxSearchQueryLength)
+	}
+	tsQuery := buildTSQuery(req.Query)
+	if tsQuery == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query must contain at least one word")
+	}
+
+	limit := pageSize(req.First)
+	query := s.db.Model(&models.Post{}).
+		Select("posts.*, ts_rank_cd(posts.search_vector, q.query) AS rank, ts_headline('english', posts.content, q.query, ?) AS snippet", headlineOptions).
+		Joins("CROSS JOIN to_tsquery('english', ?) AS q(query)", tsQuery).
+		Where("posts.search_vector @@ q.query").
+		Where("posts.deleted_at IS NULL").
+		Scopes(visiblePosts)
+	if req.After != "" {
+		rank, createdAt, id, err := decodeSearchCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		query = query.Where("(ts_rank_cd(posts.search_vector, q.query), posts.created_at, posts.id) < (?::real, ?, ?)", rank, createdAt, id)
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	var results []searchResult
+	err := query.Order("rank DESC, posts.created_at DESC, posts.id DESC").Limit(limit + 1).Scan(&results).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search posts: %v", err)
+	}
+
+	hasNextPage := len(results) > limit
+	if hasNextPage {
+		results = results[:limit]
+	}
+
+	edges := make([]*pb.SearchResultEdge, 0, len(results))
+	posts := make([]*pb.Post, 0, len(results))
+	for _, result := range results {
+		edges = append(edges, &pb.SearchResultEdge{
+			Post:    s.convertToProtoPost(result.Post),
+			Cursor:  encodeSearchCursor(result.Rank, result.CreatedAt, result.ID),
+			Snippet: highlightSnippet(result.Snippet),
+			Rank:    result.Rank,
+		})
+		posts = append(posts, edges[len(edges)-1].Post)
+	}
+	s.expandPosts(ctx, posts...)
+
+	pageInfo := buildPageInfo(edges, req.After != "", hasNextPage)
+
+	return &pb.SearchPostsResponse{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// buildTSQuery converts a user query into to_tsquery syntax. Every term
+// must match; "quoted phrases" match as adjacent words and a trailing *
+// matches prefixes. Only letters and digits reach the tsquery, so user
+// input cannot inject operators. An empty result means no usable terms.
+func buildTSQuery(query string) string {
+	var terms []string
+	rest := query
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			break
+		}
+
+		var token string
+		phrase := rest[0] == '"'
+		if phrase {
+			// An unterminated quote runs to the end of the query
+			token, rest, _ = strings.Cut(rest[1:], `"`)
+		} else if end := strings.IndexFunc(rest, unicode.IsSpace); end >= 0 {
+			token, rest = rest[:end], rest[end:]
+		} else {
+			token, rest = rest, ""
+		}
+
+		words := strings.FieldsFunc(token, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if len(words) == 0 {
+			continue
+		}
+
+		lexemes := make([]string, len(words))
+		for i, word := range words {
+			lexemes[i] = "'" + strings.ToLower(word) + "'"
+		}
+		if !phrase && strings.HasSuffix(token, "*") {
+			lexemes[len(lexemes)-1] += ":*"
+		}
+
+		// Words split by punctuation, like e-mail, match as a phrase too
+		if len(lexemes) == 1 {
+			terms = append(terms, lexemes[0])
+		} else {
+			terms = append(terms, "("+strings.Join(lexemes, " <-> ")+")")
+		}
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// highlightSnippet escapes a ts_headline excerpt and marks its matches
+func highlightSnippet(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, headlineStart, "<mark>")
+	return strings.ReplaceAll(snippet, headlineStop, "</mark>")
+}
@@ -0,0 +1,220 @@
+package grpc
+
+import (
+	"context"
+	"muze/internal/models"
+	pb "muze/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Levels of replies GetThread lists below the requested post
+const (
+	defaultThreadDepth = 3
+	maxThreadDepth     = 10
+)
+
+// maxThreadAncestors bounds the walk up to the root of a thread
+const maxThreadAncestors = 100
+
+// maxThreadNodes bounds the replies GetThread returns in all, whatever the
+// depth and page size
+const maxThreadNodes = 200
+
+// threadReply is a reply with its position among its siblings, oldest first
+type threadReply struct {
+	models.Post `gorm:"embedded"`
+	Position    int
+}
+
+// threadDepth normalizes a requested thread depth
+func threadDepth(depth int32) int {
+	if depth <= 0 {
+		return defaultThreadDepth
+	}
+	if depth > maxThreadDepth {
+		return maxThreadDepth
+	}
+	return int(depth)
+}
+
+// loadReplies returns up to limit+1 replies to each of parentIDs, oldest
+// first, so callers learn whether a parent has more. A non-nil after skips
+// the replies up to that keyset position.
+func (s *PostServer) loadReplies(parentIDs []string, limit int, after *time.Time, afterID string) (map[string][]models.Post, error) {
+	ranked := s.db.Model(&models.Post{}).
+		Select("posts.*, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY created_at, id) AS position").
+		Where("parent_id IN ?", parentIDs).
+		Where("deleted_at IS NULL").
+		Scopes(visiblePosts)
+	if after != nil {
+		ranked = ranked.Where("(created_at, id) > (?, ?)", *after, afterID)
+	}
+
+	var rows []threadReply
+	err := s.db.Table("(?) AS replies", ranked).
+		Where("position <= ?", limit+1).
+		Order("created_at, id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	replies := make(map[string][]models.Post)
+	for _, row := range rows {
+		replies[*row.ParentID] = append(replies[*row.ParentID], row.Post)
+	}
+	return replies, nil
+}
+
+// threadAncestors returns the posts a reply answers, from the root of the
+// thread down to its parent. Deleted and hidden ancestors are left out.
+func (s *PostServer) threadAncestors(post models.Post) ([]models.Post, error) {
+	if post.ParentID == nil {
+		return nil, nil
+	}
+
+	// Deleted and hidden posts still link their replies to the rest of the
+	// thread, so the walk includes them
+	var ids []string
+	err := s.db.Raw(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 1 AS depth FROM posts WHERE id = ?
+			UNION ALL
+			SELECT posts.id, posts.parent_id, ancestors.depth + 1
+			FROM posts JOIN ancestors ON posts.id = ancestors.parent_id
+			WHERE ancestors.depth < ?
+		)
+		SELECT id FROM ancestors ORDER BY depth DESC`, *post.ParentID, maxThreadAncestors).
+		Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return s.loadPosts(ids)
+}
+
+// GetThread returns the conversation around a post: the posts it replies
+// to and the tree of replies below it, depth levels deep. Every post lists
+// a page of its replies; after pages through the replies to the requested
+// post. The tree stops growing at maxThreadNodes replies, and posts whose
+// replies were cut off report a next page.
+func (s *PostServer) GetThread(ctx context.Context, req *pb.GetThreadRequest) (*pb.GetThreadResponse, error) {
+	limit := pageSize(req.First)
+	depth := threadDepth(req.Depth)
+
+	var after *time.Time
+	var afterID string
+	if req.After != "" {
+		createdAt, id, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		after, afterID = &createdAt, id
+	}
+
+	if !isUUID(req.PostId) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+	loaded, err := s.loadPosts([]string{req.PostId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+	if len(loaded) == 0 {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+	post := loaded[0]
+
+	ancestors, err := s.threadAncestors(post)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get thread: %v", err)
+	}
+
+	resp := &pb.GetThreadResponse{
+		Thread: &pb.ThreadNode{Post: s.convertToProtoPost(post)},
+	}
+	posts := []*pb.Post{resp.Thread.Post}
+	for _, ancestor := range ancestors {
+		resp.Ancestors = append(resp.Ancestors, s.convertToProtoPost(ancestor))
+	}
+	posts = append(posts, resp.Ancestors...)
+
+	// Load the tree a level at a time, one query per level, until it
+	// holds maxThreadNodes replies
+	budget := maxThreadNodes
+	level := []*pb.ThreadNode{resp.Thread}
+	nodes := level
+	for d := 0; d < depth && len(level) > 0 && budget > 0; d++ {
+		// Only ask for the replies of the posts the budget can cover
+		var parentIDs []string
+		queried := make(map[string]bool)
+		wanted := 0
+		for _, node := range level {
+			if wanted >= budget {
+				break
+			}
+			if node.Post.ReplyCount > 0 {
+				parentIDs = append(parentIDs, node.Post.Id)
+				queried[node.Post.Id] = true
+				wanted += min(int(node.Post.ReplyCount), limit)
+			}
+		}
+		if len(parentIDs) == 0 {
+			break
+		}
+
+		// The cursor only pages through the replies to the requested post
+		levelAfter := after
+		if d > 0 {
+			levelAfter = nil
+		}
+		replies, err := s.loadReplies(parentIDs, limit, levelAfter, afterID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get replies: %v", err)
+		}
+
+		var next []*pb.ThreadNode
+		for _, node := range level {
+			if !queried[node.Post.Id] {
+				continue
+			}
+
+			page := replies[node.Post.Id]
+			node.PageInfo = &pb.PageInfo{
+				HasNextPage:     len(page) > limit,
+				HasPreviousPage: levelAfter != nil,
+			}
+			if len(page) > limit {
+				page = page[:limit]
+			}
+			if len(page) > budget {
+				page = page[:budget]
+				node.PageInfo.HasNextPage = true
+			}
+			budget -= len(page)
+			if len(page) > 0 {
+				node.PageInfo.StartCursor = encodeCursor(page[0].CreatedAt, page[0].ID)
+				node.PageInfo.EndCursor = encodeCursor(page[len(page)-1].CreatedAt, page[len(page)-1].ID)
+			}
+
+			for _, reply := range page {
+				child := &pb.ThreadNode{Post: s.convertToProtoPost(reply)}
+				node.Replies = append(node.Replies, child)
+				next = append(next, child)
+				posts = append(posts, child.Post)
+			}
+		}
+		level = next
+		nodes = append(nodes, next...)
+	}
+
+	// Posts whose replies were not listed only tell whether they have any
+	for _, node := range nodes {
+		if node.PageInfo == nil {
+			node.PageInfo = &pb.PageInfo{HasNextPage: node.Post.ReplyCount > 0}
+		}
+	}
+
+	s.expandPosts(ctx, posts...)
+	return resp, nil
+}
@@ -0,0 +1,278 @@
+package grpc
+
+import (
+	"encoding/json"
+	"log"
+	"muze/internal/cache"
+	"muze/internal/messaging"
+	"muze/internal/models"
+	pb "muze/proto"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// Authors with more followers than this are merged into timelines on
+	// read instead of being pushed to every follower
+	fanOutOnReadThreshold = 10000
+
+	// Large accounts go back to fan-out-on-write once they have fewer
+	// followers than this, so accounts near fanOutOnReadThreshold don't
+	// switch on every follow and unfollow
+	fanOutOnWriteThreshold = 9000
+
+	// fanOutBatchSize is the number of timelines written per round trip
+	fanOutBatchSize = 1000
+
+	timelineFanOutQueue = "timeline-fanout"
+)
+
+// StartTimelineFanOut pushes new posts and reposts into their followers'
+// timelines and removes deleted ones. Replicas share the work through a NATS queue group.
+func (s *PostServer) StartTimelineFanOut() error {
+	_, err := messaging.QueueSubscribeToPostEvents(timelineFanOutQueue, func(subject string, data []byte) {
+		var err error
+		switch subject {
+		case messaging.SubjectPostCreated:
+			var event messaging.PostCreatedEvent
+			if err = json.Unmarshal(data, &event); err == nil {
+				err = s.fanOutPost(event.PostID)
+			}
+		case messaging.SubjectPostReposted:
+			var event messaging.PostRepostedEvent
+			if err = json.Unmarshal(data, &event); err == nil {
+				err = s.fanOutPost(event.RepostID)
+			}
+		case messaging.SubjectPostDeleted:
+			var event messaging.PostDeletedEvent
+			if err = json.Unmarshal(data, &event); err == nil {
+				err = s.removeFromTimelines(event.PostID, event.AuthorID)
+			}
+		}
+		if err != nil {
+			log.Printf("Failed to update timelines for %s event: %v", subject, err)
+		}
+	})
+	return err
+}
+
+// fanOutPost pushes a new post into the timelines of its author's followers
+func (s *PostServer) fanOutPost(postID string) error {
+	// Events only carry second precision, timelines need the exact
+	// creation time to page like the database
+	posts, err := s.loadPosts([]string{postID})
+	if err != nil || len(posts) == 0 {
+		return err
+	}
+	post := posts[0]
+
+	var followers int64
+	if err := s.db.Model(&models.Follow{}).Where("followee_id = ?", post.AuthorID).Count(&followers).Error; err != nil {
+		return err
+	}
+	if followers > fanOutOnReadThreshold {
+		return cache.MarkLargeAccount(post.AuthorID)
+	}
+	large, err := leaveFanOutOnRead(s.db, post.AuthorID, followers)
+	if err != nil || large {
+		return err
+	}
+
+	entry := cache.TimelineEntry{PostID: post.ID, CreatedAt: post.CreatedAt}
+	return forEachFollowerBatch(s.db, post.AuthorID, func(ids []string) error {
+		return cache.PushToTimelines(entry, ids)
+	})
+}
+
+// leaveFanOutOnRead returns a large account with fewer than
+// fanOutOnWriteThreshold followers to fan-out-on-write. Its followers'
+// timelines lack the posts that were merged on read, so they are dropped
+// and rebuilt on their next read. large reports whether the account is
+// still merged on read.
+func leaveFanOutOnRead(db *gorm.DB, authorID string, followers int64) (large bool, err error) {
+	marked, err := cache.FilterLargeAccounts([]string{authorID})
+	if err != nil || len(marked) == 0 {
+		return false, err
+	}
+	if followers >= fanOutOnWriteThreshold {
+		return true, nil
+	}
+
+	unmarked, err := cache.UnmarkLargeAccount(authorID)
+	if err != nil {
+		return true, err
+	}
+	if !unmarked {
+		// Another replica already moved the account
+		return false, nil
+	}
+	return false, forEachFollowerBatch(db, authorID, cache.InvalidateTimelines)
+}
+
+// removeFromTimelines removes a deleted post from the timelines it was
+// pushed to. Reads skip deleted posts either way.
+func (s *PostServer) removeFromTimelines(postID, authorID string) error {
+	large, err := cache.FilterLargeAccounts([]string{authorID})
+	if err != nil || len(large) > 0 {
+		return err
+	}
+
+	return forEachFollowerBatch(s.db, authorID, func(ids []string) error {
+		return cache.RemoveFromTimelines(postID, ids)
+	})
+}
+
+// forEachFollowerBatch calls fn with the followers of authorID in batches
+func forEachFollowerBatch(db *gorm.DB, authorID string, fn func(ids []string) error) error {
+	after := ""
+	for {
+		var ids []string
+		err := db.Model(&models.Follow{}).
+			Where("followee_id = ? AND follower_id > ?", authorID, after).
+			Order("follower_id").
+			Limit(fanOutBatchSize).
+			Pluck("follower_id", &ids).Error
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := fn(ids); err != nil {
+			return err
+		}
+		if len(ids) < fanOutBatchSize {
+			return nil
+		}
+		after = ids[len(ids)-1]
+	}
+}
+
+// getHomeFeedFromTimeline serves a home feed page from the user's timeline,
+// rebuilding it when it is cold and merging in posts of large accounts.
+// ok is false when the caller must fall back to the database.
+func (s *PostServer) getHomeFeedFromTimeline(userID, after string, limit int) (resp *pb.GetPostsResponse, ok bool, err error) {
+	var createdAt time.Time
+	var id string
+	if after != "" {
+		createdAt, id, err = decodeCursor(after)
+		if err != nil {
+			return nil, false, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+	}
+
+	// Fetch one extra entry to learn whether another page exists
+	entries, err := cache.GetTimelinePage(userID, createdAt, id, limit+1)
+	if err == cache.ErrTimelineMiss && after == "" {
+		if s.rebuildTimeline(userID) {
+			entries, err = cache.GetTimelinePage(userID, createdAt, id, limit+1)
+		}
+	}
+	if err != nil {
+		return nil, false, nil
+	}
+
+	// Large accounts are not pushed to timelines, so read them now
+	large, err := s.largeFollowees(userID)
+	if err != nil {
+		return nil, false, nil
+	}
+	if len(large) > 0 {
+		query := s.db.Model(&models.Post{}).Scopes(visiblePosts).Select("id", "created_at").Where("author_id IN ?", large)
+		if after != "" {
+			query = query.Where("(created_at, id) < (?, ?)", createdAt, id)
+		}
+
+		var posts []models.Post
+		if err := query.Order("created_at DESC, id DESC").Limit(limit + 1).Find(&posts).Error; err != nil {
+			return nil, false, nil
+		}
+		entries = mergeTimelineEntries(entries, posts)
+	}
+
+	hasNextPage := len(entries) > limit
+	if hasNextPage {
+		entries = entries[:limit]
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.PostID)
+	}
+
+	// Hydrate through the per-post cache; deleted posts are skipped
+	posts, err := s.loadPosts(ids)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	return s.buildPostConnection(posts, after != "", hasNextPage), true, nil
+}
+
+// rebuildTimeline loads the newest posts of the user's followees into the
+// user's timeline
+func (s *PostServer) rebuildTimeline(userID string) bool {
+	followees := s.db.Model(&models.Follow{}).Select("followee_id").Where("follower_id = ?", userID)
+
+	var posts []models.Post
+	err := s.db.Model(&models.Post{}).
+		Scopes(visiblePosts).
+		Select("id", "created_at").
+		Where("author_id IN (?)", followees).
+		Order("created_at DESC, id DESC").
+		Limit(cache.TimelineMaxLength).
+		Find(&posts).Error
+	if err != nil {
+		return false
+	}
+
+	entries := make([]cache.TimelineEntry, 0, len(posts))
+	for _, post := range posts {
+		entries = append(entries, cache.TimelineEntry{PostID: post.ID, CreatedAt: post.CreatedAt})
+	}
+
+	if err := cache.RebuildTimeline(userID, entries); err != nil {
+		log.Printf("Failed to rebuild timeline: %v", err)
+		return false
+	}
+	return true
+}
+
+// largeFollowees returns the accounts the user follows whose posts are
+// merged on read
+func (s *PostServer) largeFollowees(userID string) ([]string, error) {
+	var followees []string
+	err := s.db.Model(&models.Follow{}).Where("follower_id = ?", userID).Pluck("followee_id", &followees).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return cache.FilterLargeAccounts(followees)
+}
+
+// mergeTimelineEntries adds posts to the entries, dropping duplicates and
+// keeping the (created_at DESC, id DESC) order
+func mergeTimelineEntries(entries []cache.TimelineEntry, posts []models.Post) []cache.TimelineEntry {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		seen[entry.PostID] = true
+	}
+	for _, post := range posts {
+		if !seen[post.ID] {
+			entries = append(entries, cache.TimelineEntry{PostID: post.ID, CreatedAt: post.CreatedAt})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].CreatedAt.Equal(entries[j].CreatedAt) {
+			return entries[i].CreatedAt.After(entries[j].CreatedAt)
+		}
+		return entries[i].PostID > entries[j].PostID
+	})
+	return entries
+}
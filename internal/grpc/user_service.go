@@ -0,0 +1,228 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"muze/internal/database"
+	"muze/internal/models"
+	pb "muze/proto"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gorm.io/gorm"
+)
+
+// maxUsersPerBatch bounds GetUsersByIds requests
+const maxUsersPerBatch = 100
+
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	db *gorm.DB
+}
+
+func NewUserServer() *UserServer {
+	return &UserServer{
+		db: database.DB,
+	}
+}
+
+// Helper function to convert Go model to protobuf User
+func convertToProtoUser(user models.User) *pb.User {
+	var avatar, bio *wrapperspb.StringValue
+	if user.Avatar != nil {
+		avatar = wrapperspb.String(*user.Avatar)
+	}
+	if user.Bio != nil {
+		bio = wrapperspb.String(*user.Bio)
+	}
+
+	return &pb.User{
+		Id:        user.ID,
+		Name:      user.Name,
+		Avatar:    avatar,
+		Bio:       bio,
+		CreatedAt: user.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// optionalString maps an empty wrapper value to nil so it clears the column
+func optionalString(value *wrapperspb.StringValue) *string {
+	if value == nil || value.Value == "" {
+		return nil
+	}
+	return &value.Value
+}
+
+// isUUID reports whether id can be stored in a uuid column
+func isUUID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
+
+func (s *UserServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	// Profiles are created for the authenticated caller
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isUUID(claims.UserID) {
+		return nil, status.Errorf(codes.InvalidArgument, "user id must be a UUID")
+	}
+
+	// Validate input
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name cannot be empty")
+	}
+
+	user := models.User{
+		ID:     claims.UserID,
+		Name:   name,
+		Avatar: optionalString(req.Avatar),
+		Bio:    optionalString(req.Bio),
+	}
+
+	err = s.db.Create(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, status.Errorf(codes.AlreadyExists, "user already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+	}
+
+	return convertToProtoUser(user), nil
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	users, err := s.loadUsers([]string{req.Id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	user, ok := users[req.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	return user, nil
+}
+
+func (s *UserServer) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.User, error) {
+	// Users can only edit their own profile
+	claims, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if req.Name != nil && strings.TrimSpace(req.Name.Value) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name cannot be empty")
+	}
+
+	user, err := s.GetUser(ctx, &pb.GetUserRequest{Id: claims.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		updates["name"] = strings.TrimSpace(req.Name.Value)
+	}
+	if req.Avatar != nil {
+		updates["avatar"] = optionalString(req.Avatar)
+	}
+	if req.Bio != nil {
+		updates["bio"] = optionalString(req.Bio)
+	}
+	if len(updates) == 0 {
+		return user, nil
+	}
+	updates["updated_at"] = time.Now()
+
+	err = s.db.Model(&models.User{ID: claims.UserID}).Updates(updates).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update profile: %v", err)
+	}
+
+	return s.GetUser(ctx, &pb.GetUserRequest{Id: claims.UserID})
+}
+
+func (s *UserServer) GetUsersByIds(ctx context.Context, req *pb.GetUsersByIdsRequest) (*pb.GetUsersByIdsResponse, error) {
+	if len(req.Ids) > maxUsersPerBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d ids can be requested", maxUsersPerBatch)
+	}
+
+	users, err := s.loadUsers(req.Ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get users: %v", err)
+	}
+
+	resp := &pb.GetUsersByIdsResponse{Users: make([]*pb.User, 0, len(users))}
+	for _, user := range users {
+		resp.Users = append(resp.Users, user)
+	}
+	return resp, nil
+}
+
+// loadUsers returns the profiles with the given IDs, with follow counts,
+// keyed by ID. IDs without a profile are left out.
+func (s *UserServer) loadUsers(ids []string) (map[string]*pb.User, error) {
+	// IDs that cannot exist are skipped rather than failing the batch
+	var valid []string
+	for _, id := range ids {
+		if isUUID(id) {
+			valid = append(valid, id)
+		}
+	}
+	if len(valid) == 0 {
+		return map[string]*pb.User{}, nil
+	}
+
+	var users []models.User
+	if err := s.db.Where("id IN ?", valid).Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	followers, err := s.countFollows("followee_id", valid)
+	if err != nil {
+		return nil, err
+	}
+	following, err := s.countFollows("follower_id", valid)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*pb.User, len(users))
+	for _, user := range users {
+		pbUser := convertToProtoUser(user)
+		pbUser.FollowerCount = int32(followers[user.ID])
+		pbUser.FollowingCount = int32(following[user.ID])
+		result[user.ID] = pbUser
+	}
+	return result, nil
+}
+
+// countFollows counts the follows grouped by column for the given users
+func (s *UserServer) countFollows(column string, ids []string) (map[string]int, error) {
+	var rows []struct {
+		UserID string
+		Count  int
+	}
+	err := s.db.Model(&models.Follow{}).
+		Select(column+" AS user_id, COUNT(*) AS count").
+		Where(column+" IN ?", ids).
+		Group(column).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
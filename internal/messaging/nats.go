@@ -6,12 +6,32 @@ import (
 	"log"
 	"muze/internal/models"
 	"os"
+	"time"
 
 	"github.com/nats-io/nats.go"
 )
 
 var NatsClient *nats.Conn
 
+// Post event subjects
+const (
+	SubjectPostCreated   = "post.created"
+	SubjectPostLiked     = "post.liked"
+	SubjectPostUpdated   = "post.updated"
+	SubjectPostDeleted   = "post.deleted"
+	SubjectPostUnliked   = "post.unliked"
+	SubjectPostCommented = "post.commented"
+	SubjectPostMentioned = "post.mentioned"
+	SubjectPostReacted   = "post.reacted"
+	SubjectPostUnreacted = "post.unreacted"
+	SubjectPostReposted  = "post.reposted"
+)
+
+// User event subjects
+const (
+	SubjectUserFollowed = "user.followed"
+)
+
 func InitNATS() {
 	var err error
 	NatsClient, err = nats.Connect(fmt.Sprintf("nats://%s:%s", os.Getenv("NATS_HOST"), os.Getenv("NATS_PORT")))
@@ -25,13 +45,17 @@ func InitNATS() {
 // PublishPostCreated publishes new post event
 func PublishPostCreated(post models.Post) error {
 	event := PostCreatedEvent{
-		PostID:     post.ID,
-		Content:    post.Content,
-		AuthorID:   post.AuthorID,
-		AuthorName: post.AuthorName,
-		ImageURL:   post.ImageURL,
-		Likes:      post.Likes,
-		Timestamp:  post.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		PostID:       post.ID,
+		Content:      post.Content,
+		AuthorID:     post.AuthorID,
+		AuthorName:   post.AuthorName,
+		ImageURL:     post.ImageURL,
+		Media:        post.Media,
+		QuotedPostID: post.QuotedPostID,
+		ParentID:     post.ParentID,
+		RootID:       post.RootID,
+		Likes:        post.Likes,
+		Timestamp:    post.CreatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 
 	eventJSON, err := json.Marshal(event)
@@ -39,13 +63,32 @@ func PublishPostCreated(post models.Post) error {
 		return err
 	}
 
-	return NatsClient.Publish("post.created", eventJSON)
+	return NatsClient.Publish(SubjectPostCreated, eventJSON)
 }
 
 // PublishPostLiked publishes post liked event
 func PublishPostLiked(post models.Post, userID string) error {
 	event := PostLikedEvent{
 		PostID:    post.ID,
+		AuthorID:  post.AuthorID,
+		UserID:    userID,
+		Likes:     post.Likes,
+		Timestamp: post.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostLiked, eventJSON)
+}
+
+// PublishPostUnliked publishes post unliked event
+func PublishPostUnliked(post models.Post, userID string) error {
+	event := PostUnlikedEvent{
+		PostID:    post.ID,
+		AuthorID:  post.AuthorID,
 		UserID:    userID,
 		Likes:     post.Likes,
 		Timestamp: post.UpdatedAt.Format("2006-01-02T15:04:05Z"),
@@ -56,7 +99,177 @@ func PublishPostLiked(post models.Post, userID string) error {
 		return err
 	}
 
-	return NatsClient.Publish("post.liked", eventJSON)
+	return NatsClient.Publish(SubjectPostUnliked, eventJSON)
+}
+
+// PublishPostReacted publishes post reacted event. Likes are also
+// published as post.liked for existing subscribers.
+func PublishPostReacted(post models.Post, userID, reaction string) error {
+	event := PostReactedEvent{
+		PostID:         post.ID,
+		AuthorID:       post.AuthorID,
+		UserID:         userID,
+		Reaction:       reaction,
+		ReactionCounts: post.ReactionCounts,
+		Timestamp:      post.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostReacted, eventJSON)
+}
+
+// PublishPostUnreacted publishes post unreacted event
+func PublishPostUnreacted(post models.Post, userID, reaction string) error {
+	event := PostUnreactedEvent{
+		PostID:         post.ID,
+		AuthorID:       post.AuthorID,
+		UserID:         userID,
+		Reaction:       reaction,
+		ReactionCounts: post.ReactionCounts,
+		Timestamp:      post.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostUnreacted, eventJSON)
+}
+
+// PublishPostReposted publishes post reposted event. Undone reposts are
+// published as deleted posts.
+func PublishPostReposted(original, repost models.Post) error {
+	event := PostRepostedEvent{
+		PostID:      original.ID,
+		AuthorID:    original.AuthorID,
+		UserID:      repost.AuthorID,
+		RepostID:    repost.ID,
+		RepostCount: original.RepostCount,
+		Timestamp:   repost.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostReposted, eventJSON)
+}
+
+// PublishPostUpdated publishes post updated event
+func PublishPostUpdated(post models.Post) error {
+	event := PostUpdatedEvent{
+		PostID:    post.ID,
+		Content:   post.Content,
+		AuthorID:  post.AuthorID,
+		ImageURL:  post.ImageURL,
+		Media:     post.Media,
+		Timestamp: post.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostUpdated, eventJSON)
+}
+
+// PublishPostDeleted publishes post deleted event
+func PublishPostDeleted(post models.Post, deletedBy string) error {
+	event := PostDeletedEvent{
+		PostID:    post.ID,
+		AuthorID:  post.AuthorID,
+		DeletedBy: deletedBy,
+		Timestamp: time.Now().Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostDeleted, eventJSON)
+}
+
+// PublishPostCommented publishes post commented event
+func PublishPostCommented(post models.Post, comment models.Comment) error {
+	event := PostCommentedEvent{
+		PostID:       post.ID,
+		AuthorID:     post.AuthorID,
+		CommentID:    comment.ID,
+		UserID:       comment.AuthorID,
+		Content:      comment.Content,
+		CommentCount: post.CommentCount,
+		Timestamp:    comment.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostCommented, eventJSON)
+}
+
+// PublishPostMentioned publishes post mentioned event for a mentioned user
+func PublishPostMentioned(post models.Post, userID string) error {
+	event := PostMentionedEvent{
+		PostID:    post.ID,
+		AuthorID:  post.AuthorID,
+		UserID:    userID,
+		Content:   post.Content,
+		Timestamp: post.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectPostMentioned, eventJSON)
+}
+
+// PublishUserFollowed publishes user followed event
+func PublishUserFollowed(followerID, followeeID string) error {
+	event := UserFollowedEvent{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		Timestamp:  time.Now().Format("2006-01-02T15:04:05Z"),
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(SubjectUserFollowed, eventJSON)
+}
+
+// PublishNotification delivers a new or updated notification to its user
+func PublishNotification(notification models.Notification) error {
+	notificationJSON, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	return NatsClient.Publish(notificationSubject(notification.UserID), notificationJSON)
+}
+
+// SubscribeToNotifications subscribes to the notifications of one user
+func SubscribeToNotifications(userID string, handler func([]byte)) (*nats.Subscription, error) {
+	return NatsClient.Subscribe(notificationSubject(userID), func(msg *nats.Msg) {
+		handler(msg.Data)
+	})
+}
+
+func notificationSubject(userID string) string {
+	return "notification." + userID
 }
 
 // SubscribeToPosts subscribes to post events
@@ -66,20 +279,125 @@ func SubscribeToPosts(handler func([]byte)) (*nats.Subscription, error) {
 	})
 }
 
+// SubscribeToPostEvents subscribes to post events along with their subject
+func SubscribeToPostEvents(handler func(subject string, data []byte)) (*nats.Subscription, error) {
+	return NatsClient.Subscribe("post.*", func(msg *nats.Msg) {
+		handler(msg.Subject, msg.Data)
+	})
+}
+
+// QueueSubscribeToPostEvents subscribes to post events as a member of a
+// queue group, so each event is handled by only one subscriber in the group
+func QueueSubscribeToPostEvents(queue string, handler func(subject string, data []byte)) (*nats.Subscription, error) {
+	return NatsClient.QueueSubscribe("post.*", queue, func(msg *nats.Msg) {
+		handler(msg.Subject, msg.Data)
+	})
+}
+
+// QueueSubscribeToUserEvents subscribes to user events as a member of a
+// queue group
+func QueueSubscribeToUserEvents(queue string, handler func(subject string, data []byte)) (*nats.Subscription, error) {
+	return NatsClient.QueueSubscribe("user.*", queue, func(msg *nats.Msg) {
+		handler(msg.Subject, msg.Data)
+	})
+}
+
 // Event structures
 type PostCreatedEvent struct {
-	PostID     string  `json:"post_id"`
-	Content    string  `json:"content"`
-	AuthorID   string  `json:"author_id"`
-	AuthorName string  `json:"author_name"`
-	ImageURL   *string `json:"image_url"`
-	Likes      int     `json:"likes"`
-	Timestamp  string  `json:"timestamp"`
+	PostID       string             `json:"post_id"`
+	Content      string             `json:"content"`
+	AuthorID     string             `json:"author_id"`
+	AuthorName   string             `json:"author_name"`
+	ImageURL     *string            `json:"image_url"`
+	Media        []models.PostMedia `json:"media,omitempty"`
+	QuotedPostID *string            `json:"quoted_post_id,omitempty"`
+	ParentID     *string            `json:"parent_id,omitempty"`
+	RootID       *string            `json:"root_id,omitempty"`
+	Likes        int                `json:"likes"`
+	Timestamp    string             `json:"timestamp"`
 }
 
 type PostLikedEvent struct {
 	PostID    string `json:"post_id"`
+	AuthorID  string `json:"author_id"`
 	UserID    string `json:"user_id"`
 	Likes     int    `json:"likes"`
 	Timestamp string `json:"timestamp"`
 }
+
+type PostUnlikedEvent struct {
+	PostID    string `json:"post_id"`
+	AuthorID  string `json:"author_id"`
+	UserID    string `json:"user_id"`
+	Likes     int    `json:"likes"`
+	Timestamp string `json:"timestamp"`
+}
+
+type PostReactedEvent struct {
+	PostID         string         `json:"post_id"`
+	AuthorID       string         `json:"author_id"`
+	UserID         string         `json:"user_id"`
+	Reaction       string         `json:"reaction"`
+	ReactionCounts map[string]int `json:"reaction_counts"`
+	Timestamp      string         `json:"timestamp"`
+}
+
+type PostUnreactedEvent struct {
+	PostID         string         `json:"post_id"`
+	AuthorID       string         `json:"author_id"`
+	UserID         string         `json:"user_id"`
+	Reaction       string         `json:"reaction"`
+	ReactionCounts map[string]int `json:"reaction_counts"`
+	Timestamp      string         `json:"timestamp"`
+}
+
+// PostRepostedEvent is about the reposted post; UserID reposted it as
+// RepostID
+type PostRepostedEvent struct {
+	PostID      string `json:"post_id"`
+	AuthorID    string `json:"author_id"`
+	UserID      string `json:"user_id"`
+	RepostID    string `json:"repost_id"`
+	RepostCount int    `json:"repost_count"`
+	Timestamp   string `json:"timestamp"`
+}
+
+type PostUpdatedEvent struct {
+	PostID    string             `json:"post_id"`
+	Content   string             `json:"content"`
+	AuthorID  string             `json:"author_id"`
+	ImageURL  *string            `json:"image_url"`
+	Media     []models.PostMedia `json:"media,omitempty"`
+	Timestamp string             `json:"timestamp"`
+}
+
+type PostDeletedEvent struct {
+	PostID    string `json:"post_id"`
+	AuthorID  string `json:"author_id"`
+	DeletedBy string `json:"deleted_by"`
+	Timestamp string `json:"timestamp"`
+}
+
+type PostCommentedEvent struct {
+	PostID       string `json:"post_id"`
+	AuthorID     string `json:"author_id"`
+	CommentID    string `json:"comment_id"`
+	UserID       string `json:"user_id"`
+	Content      string `json:"content"`
+	CommentCount int    `json:"comment_count"`
+	Timestamp    string `json:"timestamp"`
+}
+
+type PostMentionedEvent struct {
+	PostID    string `json:"post_id"`
+	AuthorID  string `json:"author_id"`
+	UserID    string `json:"user_id"`
+	Content   string `json:"content"`
+	Timestamp string `json:"timestamp"`
+}
+
+type UserFollowedEvent struct {
+	FollowerID string `json:"follower_id"`
+	FolloweeID string `json:"followee_id"`
+	Timestamp  string `json:"timestamp"`
+}
@@ -7,25 +7,241 @@ import (
 )
 
 type Post struct {
+	ID             string         `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Content        string         `json:"content" gorm:"not null"`
+	AuthorID       string         `json:"author_id" gorm:"not null"`
+	AuthorName     string         `json:"author_name" gorm:"not null"`
+	ImageURL       *string        `json:"image_url"`
+	Likes          int            `json:"likes" gorm:"default:0"`
+	CommentCount   int            `json:"comment_count" gorm:"default:0"`
+	ReactionCounts map[string]int `json:"reaction_counts" gorm:"type:jsonb;serializer:json"`
+	Entities       []PostEntity   `json:"entities" gorm:"type:jsonb;serializer:json"`
+	Media          []PostMedia    `json:"media" gorm:"type:jsonb;serializer:json"`
+	RepostOfID     *string        `json:"repost_of_id" gorm:"type:uuid;index"`
+	QuotedPostID   *string        `json:"quoted_post_id" gorm:"type:uuid"`
+	RepostCount    int            `json:"repost_count" gorm:"default:0"`
+	ParentID       *string        `json:"parent_id" gorm:"type:uuid"`
+	RootID         *string        `json:"root_id" gorm:"type:uuid"`
+	ReplyCount     int            `json:"reply_count" gorm:"default:0"`
+	HiddenAt       *time.Time     `json:"hidden_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+// Post entity types
+const (
+	EntityHashtag = "hashtag"
+	EntityMention = "mention"
+)
+
+// PostEntity is a hashtag or a mention in a post's content. Start and End
+// are rune offsets into the content, End exclusive, and include the # or @.
+type PostEntity struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Start  int    `json:"start"`
+	End    int    `json:"end"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+type PostTag struct {
+	ID     string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	PostID string `json:"post_id" gorm:"type:uuid;not null;uniqueIndex:idx_post_tags_post_tag"`
+	Tag    string `json:"tag" gorm:"not null;uniqueIndex:idx_post_tags_post_tag"`
+}
+
+type PostMention struct {
+	ID     string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	PostID string `json:"post_id" gorm:"type:uuid;not null;uniqueIndex:idx_post_mentions_post_user"`
+	UserID string `json:"user_id" gorm:"not null;uniqueIndex:idx_post_mentions_post_user"`
+}
+
+type User struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name      string    `json:"name" gorm:"not null"`
+	Avatar    *string   `json:"avatar"`
+	Bio       *string   `json:"bio"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Follow struct {
+	ID         string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	FollowerID string    `json:"follower_id" gorm:"not null;uniqueIndex:idx_follows_follower_followee"`
+	FolloweeID string    `json:"followee_id" gorm:"not null;uniqueIndex:idx_follows_follower_followee"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// PostLike is the table likes lived in before reactions; it is only kept
+// so the migration can move its rows into post_reactions.
+type PostLike struct {
+	ID     string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	PostID string `json:"post_id" gorm:"not null;uniqueIndex:idx_post_likes_post_user"`
+	UserID string `json:"user_id" gorm:"not null;uniqueIndex:idx_post_likes_post_user"`
+}
+
+// PostReaction is one user's reaction of one type on a post. A user may
+// react to a post with several types but with each type only once.
+type PostReaction struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	PostID    string    `json:"post_id" gorm:"type:uuid;not null;uniqueIndex:idx_post_reactions_post_user_type"`
+	UserID    string    `json:"user_id" gorm:"not null;uniqueIndex:idx_post_reactions_post_user_type"`
+	Type      string    `json:"type" gorm:"not null;uniqueIndex:idx_post_reactions_post_user_type"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Bookmark is a post a user saved for later. Bookmarks are private to the
+// user and never published as events.
+type Bookmark struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID    string    `json:"user_id" gorm:"not null;uniqueIndex:idx_bookmarks_user_post"`
+	PostID    string    `json:"post_id" gorm:"type:uuid;not null;uniqueIndex:idx_bookmarks_user_post"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Comment struct {
 	ID         string         `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	Content    string         `json:"content" gorm:"not null"`
+	PostID     string         `json:"post_id" gorm:"type:uuid;not null"`
 	AuthorID   string         `json:"author_id" gorm:"not null"`
 	AuthorName string         `json:"author_name" gorm:"not null"`
-	ImageURL   *string        `json:"image_url"`
-	Likes      int            `json:"likes" gorm:"default:0"`
+	Content    string         `json:"content" gorm:"not null"`
 	CreatedAt  time.Time      `json:"created_at"`
 	UpdatedAt  time.Time      `json:"updated_at"`
 	DeletedAt  gorm.DeletedAt `json:"deleted_at" gorm:"index"`
 }
 
-type User struct {
-	ID     string  `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	Name   string  `json:"name" gorm:"not null"`
-	Avatar *string `json:"avatar"`
+// Notification types
+const (
+	NotificationLike    = "like"
+	NotificationComment = "comment"
+	NotificationFollow  = "follow"
+	NotificationMention = "mention"
+	NotificationRepost  = "repost"
+)
+
+// Notification aggregates the unread actions of one type on one post, or
+// all unread follows, for a user. GroupKey is the post ID, or empty for
+// follows, and is unique among a user's unread notifications of a type.
+type Notification struct {
+	ID         string     `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID     string     `json:"user_id" gorm:"not null"`
+	Type       string     `json:"type" gorm:"not null"`
+	PostID     *string    `json:"post_id"`
+	GroupKey   string     `json:"-" gorm:"not null;default:''"`
+	ActorIDs   []string   `json:"actor_ids" gorm:"type:jsonb;serializer:json"`
+	ActorCount int        `json:"actor_count" gorm:"default:0"`
+	ReadAt     *time.Time `json:"read_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
 }
 
-type PostLike struct {
-	ID     string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	PostID string `json:"post_id" gorm:"not null"`
-	UserID string `json:"user_id" gorm:"not null"`
+// NotificationActor records each distinct actor of a notification so
+// repeated actions are not counted twice
+type NotificationActor struct {
+	NotificationID string `json:"notification_id" gorm:"primaryKey;type:uuid"`
+	ActorID        string `json:"actor_id" gorm:"primaryKey"`
+}
+
+// Media is an uploaded file. It belongs to its uploader until a post
+// references it, after which PostID is set and it cannot be reused.
+type Media struct {
+	ID           string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	OwnerID      string    `json:"owner_id" gorm:"not null;index"`
+	PostID       *string   `json:"post_id" gorm:"type:uuid;index"`
+	ContentType  string    `json:"content_type" gorm:"not null"`
+	Size         int64     `json:"size" gorm:"not null"`
+	Width        int       `json:"width" gorm:"not null"`
+	Height       int       `json:"height" gorm:"not null"`
+	Key          string    `json:"key" gorm:"not null"`
+	URL          string    `json:"url" gorm:"not null"`
+	ThumbnailKey string    `json:"thumbnail_key" gorm:"not null"`
+	ThumbnailURL string    `json:"thumbnail_url" gorm:"not null"`
+	Blurhash     string    `json:"blurhash" gorm:"not null;default:''"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Post media types
+const (
+	MediaImage = "image"
+	MediaGIF   = "gif"
+)
+
+// PostMedia is an attachment of a post, ordered by Position. Attachments
+// are stored in post_media and copied to the post for rendering, like
+// entities. MediaID is unset for image URLs supplied by clients, whose
+// size is unknown.
+type PostMedia struct {
+	ID           string  `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	PostID       string  `json:"post_id" gorm:"type:uuid;not null;uniqueIndex:idx_post_media_post_position"`
+	Position     int     `json:"position" gorm:"not null;uniqueIndex:idx_post_media_post_position"`
+	MediaID      *string `json:"media_id" gorm:"type:uuid"`
+	Type         string  `json:"type" gorm:"not null"`
+	URL          string  `json:"url" gorm:"not null"`
+	ThumbnailURL string  `json:"thumbnail_url" gorm:"not null;default:''"`
+	Width        int     `json:"width" gorm:"not null;default:0"`
+	Height       int     `json:"height" gorm:"not null;default:0"`
+	Alt          string  `json:"alt" gorm:"not null;default:''"`
+	Blurhash     string  `json:"blurhash" gorm:"not null;default:''"`
+}
+
+// Report statuses
+const (
+	ReportOpen     = "open"
+	ReportResolved = "resolved"
+)
+
+// Report reasons
+const (
+	ReportSpam           = "spam"
+	ReportHarassment     = "harassment"
+	ReportHate           = "hate"
+	ReportViolence       = "violence"
+	ReportNudity         = "nudity"
+	ReportMisinformation = "misinformation"
+	ReportOther          = "other"
+)
+
+// Report is a user's report of a post, queued for moderators while open.
+// Decision is the moderation action that resolved it.
+type Report struct {
+	ID         string     `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	PostID     string     `json:"post_id" gorm:"type:uuid;not null;index"`
+	ReporterID string     `json:"reporter_id" gorm:"not null"`
+	Reason     string     `json:"reason" gorm:"not null"`
+	Details    string     `json:"details" gorm:"not null;default:''"`
+	Status     string     `json:"status" gorm:"not null;default:open"`
+	Decision   string     `json:"decision" gorm:"not null;default:''"`
+	ResolvedBy *string    `json:"resolved_by"`
+	ResolvedAt *time.Time `json:"resolved_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// Moderation actions
+const (
+	ModerationDismiss       = "dismiss"
+	ModerationHidePost      = "hide_post"
+	ModerationRemovePost    = "remove_post"
+	ModerationSuspendAuthor = "suspend_author"
+)
+
+// ModerationAction is an entry in the audit trail of moderator decisions.
+// It is never updated or deleted.
+type ModerationAction struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	ModeratorID string    `json:"moderator_id" gorm:"not null"`
+	Action      string    `json:"action" gorm:"not null"`
+	ReportID    *string   `json:"report_id" gorm:"type:uuid"`
+	PostID      *string   `json:"post_id" gorm:"type:uuid"`
+	UserID      *string   `json:"user_id"`
+	Note        string    `json:"note" gorm:"not null;default:''"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Suspension keeps a user from posting, editing, commenting and reposting
+type Suspension struct {
+	UserID      string    `json:"user_id" gorm:"primaryKey"`
+	SuspendedBy string    `json:"suspended_by" gorm:"not null"`
+	Reason      string    `json:"reason" gorm:"not null;default:''"`
+	CreatedAt   time.Time `json:"created_at"`
 }
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+)
+
+// Storage keeps uploaded files. Keys are slash separated paths such as
+// "media/<id>.jpg".
+type Storage interface {
+	// Put stores size bytes read from r under key
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Delete removes the file stored under key. Missing files are not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the public address of the file stored under key
+	URL(key string) string
+}
+
+// Store is the storage configured by InitStorage
+var Store Storage
+
+// ErrInvalidKey is returned for keys that could escape the storage root
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// InitStorage configures Store from the environment. MEDIA_STORAGE selects
+// the backend: "local" (the default) or "s3".
+func InitStorage() {
+	switch backend := os.Getenv("MEDIA_STORAGE"); backend {
+	case "", "local":
+		Store = NewLocalStorage(getEnv("MEDIA_DIR", "uploads"), getEnv("MEDIA_BASE_URL", "http://localhost:7002/media"))
+		log.Println("Storing media on the local filesystem")
+
+	case "s3":
+		s3, err := NewS3Storage(S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			Region:    os.Getenv("S3_REGION"),
+			UseSSL:    os.Getenv("S3_USE_SSL") == "true",
+			PublicURL: os.Getenv("S3_PUBLIC_URL"),
+		})
+		if err != nil {
+			log.Fatal("Failed to configure S3 storage:", err)
+		}
+		Store = s3
+		log.Println("Storing media in S3 bucket", os.Getenv("S3_BUCKET"))
+
+	default:
+		log.Fatalf("Unknown MEDIA_STORAGE %q", backend)
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
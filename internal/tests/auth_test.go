@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"muze/internal/auth"
+	grpcService "muze/internal/grpc"
+	pb "muze/proto"
+)
+
+func TestAuth_TokenRoundTrip(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	token, err := auth.GenerateToken("user-1", "User One")
+	require.NoError(t, err)
+
+	claims, err := auth.ValidateToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.Equal(t, "User One", claims.Name)
+
+	ctx := auth.ContextWithUser(context.Background(), claims)
+	fromCtx, err := auth.ExtractUserFromContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, claims, fromCtx)
+}
+
+func TestAuth_RejectsInvalidTokens(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	expired, err := auth.GenerateTokenWithExpiry("user-1", "User One", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	_, err = auth.ValidateToken(expired)
+	assert.Error(t, err)
+
+	// Tokens signed with another secret
+	t.Setenv("JWT_SECRET", "other-secret")
+	foreign, err := auth.GenerateToken("user-1", "User One")
+	require.NoError(t, err)
+	t.Setenv("JWT_SECRET", "test-secret")
+	_, err = auth.ValidateToken(foreign)
+	assert.Error(t, err)
+
+	// Unsigned tokens
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, auth.Claims{UserID: "user-1"}).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	_, err = auth.ValidateToken(unsigned)
+	assert.Error(t, err)
+
+	// A missing secret never validates anything
+	t.Setenv("JWT_SECRET", "")
+	_, err = auth.ValidateToken(foreign)
+	assert.ErrorIs(t, err, auth.ErrMissingSecret)
+}
+
+func TestAuth_ContextRequiresTypedClaims(t *testing.T) {
+	//lint:ignore SA1029 verifies that bare string keys are not honoured
+	ctx := context.WithValue(context.Background(), "user", &auth.Claims{UserID: "user-1"})
+
+	_, err := auth.ExtractUserFromContext(ctx)
+	assert.Error(t, err)
+}
+
+func TestAuth_GRPCInterceptorInjectsClaims(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	token, err := auth.GenerateToken("user-1", "User One")
+	require.NoError(t, err)
+
+	var seen *auth.Claims
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		seen, _ = auth.ExtractUserFromContext(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: pb.PostService_CreatePost_FullMethodName}
+
+	// A valid bearer token yields claims
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	_, err = grpcService.UnaryAuthInterceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	require.NotNil(t, seen)
+	assert.Equal(t, "user-1", seen.UserID)
+
+	// Calls without a token pass through anonymously
+	seen = nil
+	_, err = grpcService.UnaryAuthInterceptor(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	assert.Nil(t, seen)
+
+	// Invalid tokens are rejected before the handler runs
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer forged"))
+	_, err = grpcService.UnaryAuthInterceptor(ctx, nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"muze/internal/cache"
+	"muze/internal/database"
+	"muze/internal/grpc"
+	"muze/internal/messaging"
+	pb "muze/proto"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// quietFilter rejects posts that shout
+type quietFilter struct{}
+
+func (quietFilter) Name() string { return "quiet" }
+
+func (quietFilter) Check(ctx context.Context, post grpc.PostCandidate) (grpc.FilterResult, error) {
+	if post.Content != "" && post.Content == strings.ToUpper(post.Content) {
+		return grpc.FilterResult{Verdict: grpc.FilterReject, Reason: "please don't shout"}, nil
+	}
+	return grpc.FilterResult{}, nil
+}
+
+func TestPostFilters_BuiltIn(t *testing.T) {
+	ctx := context.Background()
+	check := func(filter grpc.PostFilter, content string) grpc.FilterResult {
+		result, err := filter.Check(ctx, grpc.PostCandidate{AuthorID: "user1", Content: content})
+		require.NoError(t, err)
+		return result
+	}
+
+	length := grpc.MaxLengthFilter{MaxLength: 5}
+	assert.Equal(t, grpc.FilterAllow, check(length, "héllo").Verdict)
+	assert.Equal(t, grpc.FilterReject, check(length, "hello!").Verdict)
+	assert.Equal(t, grpc.FilterFlag, check(grpc.MaxLengthFilter{MaxLength: 5, Flag: true}, "hello!").Verdict)
+
+	// Banned words match whole words in any case
+	banned := grpc.BannedWordsFilter{Words: []string{"Scam"}}
+	result := check(banned, "Total SCAM, avoid")
+	assert.Equal(t, grpc.FilterReject, result.Verdict)
+	assert.Contains(t, result.Reason, "Scam")
+	assert.Equal(t, grpc.FilterAllow, check(banned, "scampi for dinner").Verdict)
+
+	links := grpc.LinkLimitFilter{MaxLinks: 1}
+	assert.Equal(t, grpc.FilterAllow, check(links, "see https://example.com").Verdict)
+	assert.Equal(t, grpc.FilterReject, check(links, "see https://example.com and www.example.org").Verdict)
+	assert.Equal(t, grpc.FilterAllow, check(grpc.LinkLimitFilter{MaxLinks: 0}, "email me at me@example.com").Verdict)
+}
+
+func TestPostService_ContentFilters(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	t.Setenv("BANNED_WORDS", "scam, spoiler")
+	t.Setenv("POST_MAX_LINKS", "1")
+	t.Setenv("POST_FILTERS_FLAG", "links")
+	t.Setenv("DUPLICATE_POST_WINDOW", "1h")
+	server := grpc.NewPostServer()
+	server.RegisterFilter(quietFilter{})
+	author := uuid.NewString()
+
+	// Every rejecting filter is reported in the details
+	_, err := server.CreatePost(asUser(author), &pb.CreatePostRequest{Content: "SCAM ALERT"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	st, _ := status.FromError(err)
+	var violations []*errdetails.BadRequest_FieldViolation
+	var reasons []string
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.BadRequest:
+			violations = detail.FieldViolations
+		case *errdetails.ErrorInfo:
+			reasons = append(reasons, detail.Reason)
+		}
+	}
+	require.Len(t, violations, 2)
+	assert.Equal(t, "content", violations[0].Field)
+	assert.Equal(t, "please don't shout", violations[1].Description)
+	assert.Equal(t, []string{"BANNED_WORDS", "QUIET"}, reasons)
+
+	// Flagged posts are created and queued for moderators
+	post, err := server.CreatePost(asUser(author), &pb.CreatePostRequest{Content: "Read https://a.example and https://b.example"})
+	require.NoError(t, err)
+	flagged := findReport(t, server, pb.ReportStatus_REPORT_STATUS_OPEN, func(r *pb.Report) bool { return r.PostId == post.Id })
+	require.NotNil(t, flagged)
+	assert.Equal(t, "content-filter", flagged.ReporterId)
+	assert.True(t, strings.HasPrefix(flagged.Details, "links: "))
+
+	// Posting the same thing again is a duplicate, whatever the case and spacing
+	thought, err := server.CreatePost(asUser(author), &pb.CreatePostRequest{Content: "Just thinking"})
+	require.NoError(t, err)
+	_, err = server.CreatePost(asUser(author), &pb.CreatePostRequest{Content: "  just \n  Thinking "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.CreatePost(asUser(uuid.NewString()), &pb.CreatePostRequest{Content: "Just thinking"})
+	assert.NoError(t, err)
+
+	// Edits are filtered too, but never duplicate the post being edited
+	_, err = server.UpdatePost(asUser(author), &pb.UpdatePostRequest{Id: thought.Id, Content: wrapperspb.String("Just a scam")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.UpdatePost(asUser(author), &pb.UpdatePostRequest{Id: thought.Id, Content: wrapperspb.String("just thinking")})
+	assert.NoError(t, err)
+
+	edited, err := server.UpdatePost(asUser(author), &pb.UpdatePostRequest{Id: thought.Id, Content: wrapperspb.String("Links: https://a.example https://b.example")})
+	require.NoError(t, err)
+	assert.NotNil(t, findReport(t, server, pb.ReportStatus_REPORT_STATUS_OPEN, func(r *pb.Report) bool { return r.PostId == edited.Id }))
+}
@@ -0,0 +1,960 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	graph "muze/graphql"
+	"muze/internal/auth"
+	pb "muze/proto"
+)
+
+// stubPostClient records requests and answers them without a gRPC server
+type stubPostClient struct {
+	pb.PostServiceClient
+	created   []*pb.CreatePostRequest
+	liked     []*pb.LikePostRequest
+	events    []*pb.PostEvent
+	streams   []*pb.StreamPostsRequest
+	posts     []*pb.Post
+	listed    []*pb.ListCommentsRequest
+	batched   []*pb.ListCommentsByPostsRequest
+	uploads   *stubUploadStream
+	reacted   []*pb.ReactionRequest
+	fetched   []string
+	threads   []*pb.GetThreadRequest
+	bookmarks []*pb.ListBookmarksRequest
+	reported  []*pb.ReportPostRequest
+	reports   []*pb.ListReportsRequest
+	resolved  []*pb.ResolveReportRequest
+}
+
+func (c *stubPostClient) CreatePost(ctx context.Context, in *pb.CreatePostRequest, opts ...grpc.CallOption) (*pb.Post, error) {
+	c.created = append(c.created, in)
+	return &pb.Post{
+		Id:         "post-1",
+		Content:    in.Content,
+		AuthorId:   in.AuthorId,
+		AuthorName: "User " + in.AuthorId,
+		Timestamp:  "2024-01-01T00:00:00Z",
+	}, nil
+}
+
+func (c *stubPostClient) LikePost(ctx context.Context, in *pb.LikePostRequest, opts ...grpc.CallOption) (*pb.Post, error) {
+	c.liked = append(c.liked, in)
+	return &pb.Post{Id: in.PostId, Likes: 1, Timestamp: "2024-01-01T00:00:00Z"}, nil
+}
+
+func (c *stubPostClient) AddReaction(ctx context.Context, in *pb.ReactionRequest, opts ...grpc.CallOption) (*pb.Post, error) {
+	c.reacted = append(c.reacted, in)
+	return &pb.Post{
+		Id:              in.PostId,
+		Likes:           1,
+		Timestamp:       "2024-01-01T00:00:00Z",
+		Reactions:       []*pb.ReactionCount{{Type: "like", Emoji: "👍", Count: 1}, {Type: in.Type, Emoji: "❤️", Count: 2}},
+		ViewerReactions: []string{in.Type},
+	}, nil
+}
+
+func (c *stubPostClient) ListReactionTypes(ctx context.Context, in *pb.ListReactionTypesRequest, opts ...grpc.CallOption) (*pb.ListReactionTypesResponse, error) {
+	return &pb.ListReactionTypesResponse{Types: []*pb.ReactionType{{Type: "like", Emoji: "👍"}, {Type: "love", Emoji: "❤️"}}}, nil
+}
+
+func (c *stubPostClient) Repost(ctx context.Context, in *pb.RepostRequest, opts ...grpc.CallOption) (*pb.Post, error) {
+	return &pb.Post{
+		Id:         "repost-1",
+		AuthorId:   "user-1",
+		Timestamp:  "2024-01-02T00:00:00Z",
+		RepostOfId: in.PostId,
+		RepostOf:   &pb.Post{Id: in.PostId, Content: "Original", RepostCount: 1, Timestamp: "2024-01-01T00:00:00Z"},
+	}, nil
+}
+
+func (c *stubPostClient) GetThread(ctx context.Context, in *pb.GetThreadRequest, opts ...grpc.CallOption) (*pb.GetThreadResponse, error) {
+	c.threads = append(c.threads, in)
+	return &pb.GetThreadResponse{
+		Ancestors: []*pb.Post{{Id: "root-1", Content: "Root", ReplyCount: 1}},
+		Thread: &pb.ThreadNode{
+			Post: &pb.Post{Id: in.PostId, Content: "Parent", ParentId: "root-1", RootId: "root-1", ReplyCount: 3},
+			Replies: []*pb.ThreadNode{{
+				Post:     &pb.Post{Id: "reply-1", Content: "Reply", ParentId: in.PostId, RootId: "root-1", ReplyCount: 1},
+				PageInfo: &pb.PageInfo{HasNextPage: true},
+			}},
+			PageInfo: &pb.PageInfo{StartCursor: "cursor-1", EndCursor: "cursor-1", HasNextPage: true},
+		},
+	}, nil
+}
+
+func (c *stubPostClient) Bookmark(ctx context.Context, in *pb.BookmarkRequest, opts ...grpc.CallOption) (*pb.Post, error) {
+	return &pb.Post{Id: in.PostId, Content: "Saved", Timestamp: "2024-01-01T00:00:00Z", ViewerHasBookmarked: true}, nil
+}
+
+func (c *stubPostClient) ListBookmarks(ctx context.Context, in *pb.ListBookmarksRequest, opts ...grpc.CallOption) (*pb.GetPostsResponse, error) {
+	c.bookmarks = append(c.bookmarks, in)
+	return &pb.GetPostsResponse{
+		Edges: []*pb.PostEdge{{
+			Post:   &pb.Post{Id: "post-1", Content: "Saved", ViewerHasBookmarked: true},
+			Cursor: "cursor-1",
+		}},
+		PageInfo: &pb.PageInfo{StartCursor: "cursor-1", EndCursor: "cursor-1"},
+	}, nil
+}
+
+func (c *stubPostClient) ReportPost(ctx context.Context, in *pb.ReportPostRequest, opts ...grpc.CallOption) (*pb.Report, error) {
+	c.reported = append(c.reported, in)
+	return &pb.Report{
+		Id:         "report-1",
+		PostId:     in.PostId,
+		ReporterId: "user-1",
+		Reason:     in.Reason,
+		Details:    in.Details,
+		Status:     pb.ReportStatus_REPORT_STATUS_OPEN,
+		CreatedAt:  "2024-01-01T00:00:00Z",
+	}, nil
+}
+
+func (c *stubPostClient) ListReports(ctx context.Context, in *pb.ListReportsRequest, opts ...grpc.CallOption) (*pb.ListReportsResponse, error) {
+	c.reports = append(c.reports, in)
+	return &pb.ListReportsResponse{
+		Edges: []*pb.ReportEdge{{
+			Report: &pb.Report{
+				Id:     "report-1",
+				PostId: "post-1",
+				Post:   &pb.Post{Id: "post-1", Content: "Spam"},
+				Reason: pb.ReportReason_REPORT_REASON_SPAM,
+				Status: pb.ReportStatus_REPORT_STATUS_OPEN,
+			},
+			Cursor: "cursor-1",
+		}},
+		PageInfo: &pb.PageInfo{StartCursor: "cursor-1", EndCursor: "cursor-1"},
+	}, nil
+}
+
+func (c *stubPostClient) ResolveReport(ctx context.Context, in *pb.ResolveReportRequest, opts ...grpc.CallOption) (*pb.Report, error) {
+	c.resolved = append(c.resolved, in)
+	return &pb.Report{
+		Id:         in.ReportId,
+		PostId:     "post-1",
+		Reason:     pb.ReportReason_REPORT_REASON_SPAM,
+		Status:     pb.ReportStatus_REPORT_STATUS_RESOLVED,
+		Decision:   in.Decision,
+		ResolvedBy: "mod-1",
+		ResolvedAt: "2024-01-02T00:00:00Z",
+	}, nil
+}
+
+func (c *stubPostClient) ListModerationActions(ctx context.Context, in *pb.ListModerationActionsRequest, opts ...grpc.CallOption) (*pb.ListModerationActionsResponse, error) {
+	return &pb.ListModerationActionsResponse{
+		Edges: []*pb.ModerationActionEdge{{
+			Action: &pb.ModerationAction{
+				Id:          "action-1",
+				ModeratorId: "mod-1",
+				Type:        pb.ModerationActionType_MODERATION_ACTION_TYPE_SUSPEND_AUTHOR,
+				ReportId:    "report-1",
+				UserId:      "user-2",
+				CreatedAt:   "2024-01-02T00:00:00Z",
+			},
+			Cursor: "cursor-1",
+		}},
+		PageInfo: &pb.PageInfo{StartCursor: "cursor-1", EndCursor: "cursor-1"},
+	}, nil
+}
+
+// GetPostById knows the posts in c.posts
+func (c *stubPostClient) GetPostById(ctx context.Context, in *pb.GetPostByIdRequest, opts ...grpc.CallOption) (*pb.Post, error) {
+	c.fetched = append(c.fetched, in.Id)
+	for _, post := range c.posts {
+		if post.Id == in.Id {
+			return post, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "post not found")
+}
+
+func (c *stubPostClient) StreamPosts(ctx context.Context, in *pb.StreamPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[pb.PostEvent], error) {
+	c.streams = append(c.streams, in)
+
+	var events []*pb.PostEvent
+	for _, event := range c.events {
+		if len(in.PostIds) > 0 && !slices.Contains(in.PostIds, event.Post.Id) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return &stubEventStream{events: events}, nil
+}
+
+func (c *stubPostClient) GetPosts(ctx context.Context, in *pb.GetPostsRequest, opts ...grpc.CallOption) (*pb.GetPostsResponse, error) {
+	return &pb.GetPostsResponse{Posts: c.posts, Total: int32(len(c.posts))}, nil
+}
+
+func (c *stubPostClient) ListComments(ctx context.Context, in *pb.ListCommentsRequest, opts ...grpc.CallOption) (*pb.ListCommentsResponse, error) {
+	c.listed = append(c.listed, in)
+	return &pb.ListCommentsResponse{
+		Edges: []*pb.CommentEdge{{
+			Comment: &pb.Comment{Id: "comment-1", PostId: in.PostId, AuthorId: "user-2", AuthorName: "User user-2", Content: "Nice"},
+			Cursor:  "cursor-1",
+		}},
+		PageInfo: &pb.PageInfo{StartCursor: "cursor-1", EndCursor: "cursor-1", HasNextPage: true},
+	}, nil
+}
+
+func (c *stubPostClient) ListCommentsByPosts(ctx context.Context, in *pb.ListCommentsByPostsRequest, opts ...grpc.CallOption) (*pb.ListCommentsByPostsResponse, error) {
+	c.batched = append(c.batched, in)
+	resp := &pb.ListCommentsByPostsResponse{Pages: make(map[string]*pb.ListCommentsResponse)}
+	for _, id := range in.PostIds {
+		resp.Pages[id] = &pb.ListCommentsResponse{
+			Edges: []*pb.CommentEdge{{
+				Comment: &pb.Comment{Id: "comment-" + id, PostId: id, AuthorId: "user-2", AuthorName: "User user-2", Content: "Nice"},
+				Cursor:  "cursor-" + id,
+			}},
+			PageInfo: &pb.PageInfo{},
+		}
+	}
+	return resp, nil
+}
+
+func (c *stubPostClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[pb.UploadMediaRequest, pb.Media], error) {
+	c.uploads = &stubUploadStream{}
+	return c.uploads, nil
+}
+
+// stubUploadStream collects an uploaded file
+type stubUploadStream struct {
+	grpc.ClientStream
+	metadata *pb.MediaMetadata
+	data     []byte
+}
+
+func (s *stubUploadStream) Send(req *pb.UploadMediaRequest) error {
+	if metadata := req.GetMetadata(); metadata != nil {
+		s.metadata = metadata
+	}
+	s.data = append(s.data, req.GetChunk()...)
+	return nil
+}
+
+func (s *stubUploadStream) CloseAndRecv() (*pb.Media, error) {
+	return &pb.Media{Id: "media-1", Url: "http://localhost:7002/media/media-1.png", ContentType: "image/png", Size: int64(len(s.data))}, nil
+}
+
+// stubUserClient serves a fixed set of users and records batch lookups
+type stubUserClient struct {
+	pb.UserServiceClient
+	users    map[string]*pb.User
+	batches  [][]string
+	followed []string
+}
+
+func (c *stubUserClient) GetUser(ctx context.Context, in *pb.GetUserRequest, opts ...grpc.CallOption) (*pb.User, error) {
+	if user, ok := c.users[in.Id]; ok {
+		return user, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "user not found")
+}
+
+func (c *stubUserClient) GetUsersByIds(ctx context.Context, in *pb.GetUsersByIdsRequest, opts ...grpc.CallOption) (*pb.GetUsersByIdsResponse, error) {
+	c.batches = append(c.batches, in.Ids)
+
+	resp := &pb.GetUsersByIdsResponse{}
+	for _, id := range in.Ids {
+		if user, ok := c.users[id]; ok {
+			resp.Users = append(resp.Users, user)
+		}
+	}
+	return resp, nil
+}
+
+func (c *stubUserClient) Follow(ctx context.Context, in *pb.FollowRequest, opts ...grpc.CallOption) (*pb.FollowResponse, error) {
+	c.followed = append(c.followed, in.UserId)
+	return &pb.FollowResponse{UserId: in.UserId, Following: true}, nil
+}
+
+func (c *stubUserClient) ListFollowers(ctx context.Context, in *pb.ListFollowsRequest, opts ...grpc.CallOption) (*pb.ListFollowsResponse, error) {
+	return &pb.ListFollowsResponse{
+		Edges:    []*pb.UserEdge{{User: &pb.User{Id: "user-2", Name: "Grace"}, Cursor: "cursor-1"}},
+		PageInfo: &pb.PageInfo{},
+	}, nil
+}
+
+type stubNotificationClient struct {
+	pb.NotificationServiceClient
+	notifications []*pb.Notification
+	marked        [][]string
+}
+
+func (c *stubNotificationClient) ListNotifications(ctx context.Context, in *pb.ListNotificationsRequest, opts ...grpc.CallOption) (*pb.ListNotificationsResponse, error) {
+	resp := &pb.ListNotificationsResponse{PageInfo: &pb.PageInfo{}}
+	for _, notification := range c.notifications {
+		resp.Edges = append(resp.Edges, &pb.NotificationEdge{Notification: notification, Cursor: notification.Id})
+		if !notification.Read {
+			resp.UnreadCount++
+		}
+	}
+	return resp, nil
+}
+
+func (c *stubNotificationClient) MarkNotificationsRead(ctx context.Context, in *pb.MarkNotificationsReadRequest, opts ...grpc.CallOption) (*pb.UnreadCountResponse, error) {
+	c.marked = append(c.marked, in.Ids)
+	return &pb.UnreadCountResponse{}, nil
+}
+
+// stubEventStream replays a fixed list of events and then reports io.EOF
+type stubEventStream struct {
+	grpc.ClientStream
+	events []*pb.PostEvent
+}
+
+func (s *stubEventStream) Recv() (*pb.PostEvent, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+func executeGraphQL(t *testing.T, client pb.PostServiceClient, users pb.UserServiceClient, claims *auth.Claims, body map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	return executeGraphQLWith(t, graph.NewResolver(client, users, &stubNotificationClient{}), claims, body)
+}
+
+// executeGraphQLWith runs one request against a gateway built on resolver
+func executeGraphQLWith(t *testing.T, resolver *graph.Resolver, claims *auth.Claims, body map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	return serveGraphQL(t, resolver, claims, req)
+}
+
+// serveGraphQL sends req to a gateway built on resolver
+func serveGraphQL(t *testing.T, resolver *graph.Resolver, claims *auth.Claims, req *http.Request) map[string]interface{} {
+	t.Helper()
+
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(resolver.WithLoaders(ctx))
+	})
+
+	if claims != nil {
+		req = req.WithContext(auth.ContextWithUser(req.Context(), claims))
+	}
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestGraphQL_AliasesFragmentsAndVariables(t *testing.T) {
+	client := &stubPostClient{}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `
+			mutation Publish($body: String!, $target: ID!) {
+				first: createPost(content: $body) { ...PostFields }
+				liked: likePost(postId: $target) { id likes }
+			}
+			fragment PostFields on Post { id content author { id name } }
+		`,
+		"variables":     map[string]interface{}{"body": "Hello, \"Muze\"", "target": "post-9"},
+		"operationName": "Publish",
+	})
+
+	require.Nil(t, resp["errors"])
+	data := resp["data"].(map[string]interface{})
+	first := data["first"].(map[string]interface{})
+	assert.Equal(t, "Hello, \"Muze\"", first["content"])
+	assert.Equal(t, "user-1", first["author"].(map[string]interface{})["id"])
+	assert.Equal(t, "post-9", data["liked"].(map[string]interface{})["id"])
+
+	require.Len(t, client.created, 1)
+	assert.Equal(t, "user-1", client.created[0].AuthorId)
+	require.Len(t, client.liked, 1)
+	assert.Equal(t, "post-9", client.liked[0].PostId)
+}
+
+func TestGraphQL_RejectsInvalidDocuments(t *testing.T) {
+	client := &stubPostClient{}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query { getPosts { posts { doesNotExist } } }`,
+	})
+
+	assert.NotNil(t, resp["errors"])
+	assert.Nil(t, resp["data"])
+}
+
+func TestGraphQL_MutationsRequireAuthentication(t *testing.T) {
+	client := &stubPostClient{}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `mutation { createPost(content: "anonymous") { id } }`,
+	})
+
+	assert.NotNil(t, resp["errors"])
+	assert.Empty(t, client.created)
+}
+
+func TestGraphQL_SubscriptionsFilterStreamedEvents(t *testing.T) {
+	client := &stubPostClient{events: []*pb.PostEvent{
+		{Type: pb.PostEventType_POST_EVENT_TYPE_CREATED, Post: &pb.Post{Id: "post-1"}},
+		{Type: pb.PostEventType_POST_EVENT_TYPE_LIKED, Post: &pb.Post{Id: "post-2", Likes: 3}},
+		{Type: pb.PostEventType_POST_EVENT_TYPE_LIKED, Post: &pb.Post{Id: "post-1", Likes: 1}},
+	}}
+	resolver := graph.NewResolver(client, &stubUserClient{}, &stubNotificationClient{})
+	ctx := auth.ContextWithUser(context.Background(), &auth.Claims{UserID: "user-1"})
+
+	liked, err := resolver.Subscription().PostLiked(ctx, "post-1")
+	require.NoError(t, err)
+
+	var received []*graph.Post
+	for post := range liked {
+		received = append(received, post)
+	}
+	require.Len(t, received, 1)
+	assert.Equal(t, "post-1", received[0].ID)
+	assert.Equal(t, 1, received[0].Likes)
+
+	require.Len(t, client.streams, 1)
+	assert.Equal(t, "user-1", client.streams[0].UserId)
+	assert.Equal(t, []string{"post-1"}, client.streams[0].PostIds)
+
+	_, err = resolver.Subscription().PostCreated(context.Background())
+	assert.Error(t, err, "subscriptions require an authenticated connection")
+}
+
+func TestGraphQL_PostAuthorsAreBatched(t *testing.T) {
+	client := &stubPostClient{posts: []*pb.Post{
+		{Id: "post-1", AuthorId: "user-1", AuthorName: "User user-1"},
+		{Id: "post-2", AuthorId: "user-2", AuthorName: "Old Name"},
+		{Id: "post-3", AuthorId: "user-1", AuthorName: "User user-1"},
+	}}
+	users := &stubUserClient{users: map[string]*pb.User{
+		"user-1": {Id: "user-1", Name: "Ada", Bio: wrapperspb.String("Writes things")},
+	}}
+
+	resp := executeGraphQL(t, client, users, nil, map[string]interface{}{
+		"query": `query { getPosts { posts { id author { id name bio } } } }`,
+	})
+
+	require.Nil(t, resp["errors"])
+	posts := resp["data"].(map[string]interface{})["getPosts"].(map[string]interface{})["posts"].([]interface{})
+	require.Len(t, posts, 3)
+
+	author := posts[0].(map[string]interface{})["author"].(map[string]interface{})
+	assert.Equal(t, "Ada", author["name"])
+	assert.Equal(t, "Writes things", author["bio"])
+
+	// Authors without a profile fall back to the name stored on the post
+	author = posts[1].(map[string]interface{})["author"].(map[string]interface{})
+	assert.Equal(t, "user-2", author["id"])
+	assert.Equal(t, "Old Name", author["name"])
+
+	// Every author is fetched once, in a single call
+	require.Len(t, users.batches, 1)
+	assert.ElementsMatch(t, []string{"user-1", "user-2"}, users.batches[0])
+}
+
+func TestGraphQL_UserQueries(t *testing.T) {
+	users := &stubUserClient{users: map[string]*pb.User{
+		"user-1": {Id: "user-1", Name: "Ada"},
+	}}
+
+	resp := executeGraphQL(t, &stubPostClient{}, users, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `query { me { name } user(id: "missing") { name } }`,
+	})
+
+	require.Nil(t, resp["errors"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "Ada", data["me"].(map[string]interface{})["name"])
+	assert.Nil(t, data["user"])
+
+	resp = executeGraphQL(t, &stubPostClient{}, users, nil, map[string]interface{}{
+		"query": `query { me { name } }`,
+	})
+	assert.NotNil(t, resp["errors"])
+}
+
+func TestGraphQL_PostComments(t *testing.T) {
+	client := &stubPostClient{posts: []*pb.Post{
+		{Id: "post-1", AuthorId: "user-1", CommentCount: 4, Entities: []*pb.PostEntity{
+			{Type: pb.PostEntityType_POST_ENTITY_TYPE_HASHTAG, Text: "go", Start: 0, End: 3},
+			{Type: pb.PostEntityType_POST_ENTITY_TYPE_MENTION, Text: "ada", Start: 4, End: 8, UserId: "user-2"},
+		}},
+	}}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query {
+			getPosts {
+				posts {
+					commentCount
+					entities { type text start end userId }
+					comments(first: 1, after: "cursor-0") {
+						edges { cursor node { id content author { id name } } }
+						pageInfo { hasNextPage endCursor }
+					}
+				}
+			}
+		}`,
+	})
+
+	require.Nil(t, resp["errors"])
+	post := resp["data"].(map[string]interface{})["getPosts"].(map[string]interface{})["posts"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, float64(4), post["commentCount"])
+
+	entities := post["entities"].([]interface{})
+	require.Len(t, entities, 2)
+	assert.Equal(t, map[string]interface{}{"type": "HASHTAG", "text": "go", "start": float64(0), "end": float64(3), "userId": nil}, entities[0])
+	assert.Equal(t, "MENTION", entities[1].(map[string]interface{})["type"])
+	assert.Equal(t, "user-2", entities[1].(map[string]interface{})["userId"])
+
+	comments := post["comments"].(map[string]interface{})
+	edge := comments["edges"].([]interface{})[0].(map[string]interface{})
+	node := edge["node"].(map[string]interface{})
+	assert.Equal(t, "comment-1", node["id"])
+	assert.Equal(t, "User user-2", node["author"].(map[string]interface{})["name"])
+	assert.Equal(t, true, comments["pageInfo"].(map[string]interface{})["hasNextPage"])
+
+	require.Len(t, client.listed, 1)
+	assert.Equal(t, "post-1", client.listed[0].PostId)
+	assert.Equal(t, int32(1), client.listed[0].First)
+	assert.Equal(t, "cursor-0", client.listed[0].After)
+
+	// Commenting requires a token
+	resp = executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `mutation { createComment(postId: "post-1", content: "Hi") { id } }`,
+	})
+	assert.NotNil(t, resp["errors"])
+}
+
+func TestGraphQL_PostCommentsAreBatched(t *testing.T) {
+	client := &stubPostClient{posts: []*pb.Post{{Id: "post-1"}, {Id: "post-2"}, {Id: "post-3"}}}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query {
+			getPosts {
+				posts {
+					id
+					comments(first: 2) { edges { node { id } } }
+				}
+			}
+		}`,
+	})
+
+	require.Nil(t, resp["errors"])
+	posts := resp["data"].(map[string]interface{})["getPosts"].(map[string]interface{})["posts"].([]interface{})
+	require.Len(t, posts, 3)
+	for _, post := range posts {
+		post := post.(map[string]interface{})
+		edges := post["comments"].(map[string]interface{})["edges"].([]interface{})
+		require.Len(t, edges, 1)
+		assert.Equal(t, "comment-"+post["id"].(string), edges[0].(map[string]interface{})["node"].(map[string]interface{})["id"])
+	}
+
+	// One call fetches the comments of every post
+	assert.Empty(t, client.listed)
+	require.Len(t, client.batched, 1)
+	assert.ElementsMatch(t, []string{"post-1", "post-2", "post-3"}, client.batched[0].PostIds)
+	assert.Equal(t, int32(2), client.batched[0].First)
+}
+
+func TestGraphQL_FollowGraph(t *testing.T) {
+	users := &stubUserClient{users: map[string]*pb.User{
+		"user-1": {Id: "user-1", Name: "Ada", FollowerCount: 1},
+	}}
+
+	resp := executeGraphQL(t, &stubPostClient{}, users, &auth.Claims{UserID: "user-2"}, map[string]interface{}{
+		"query": `mutation { follow(userId: "user-1") }`,
+	})
+	require.Nil(t, resp["errors"])
+	assert.Equal(t, true, resp["data"].(map[string]interface{})["follow"])
+	assert.Equal(t, []string{"user-1"}, users.followed)
+
+	resp = executeGraphQL(t, &stubPostClient{}, users, nil, map[string]interface{}{
+		"query": `query { user(id: "user-1") { followerCount followers { edges { node { name } } } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	user := resp["data"].(map[string]interface{})["user"].(map[string]interface{})
+	assert.Equal(t, float64(1), user["followerCount"])
+	edges := user["followers"].(map[string]interface{})["edges"].([]interface{})
+	require.Len(t, edges, 1)
+	assert.Equal(t, "Grace", edges[0].(map[string]interface{})["node"].(map[string]interface{})["name"])
+
+	// The home feed is personal
+	resp = executeGraphQL(t, &stubPostClient{}, users, nil, map[string]interface{}{
+		"query": `query { homeFeed { edges { node { id } } } }`,
+	})
+	assert.NotNil(t, resp["errors"])
+}
+
+func TestGraphQL_Notifications(t *testing.T) {
+	users := &stubUserClient{users: map[string]*pb.User{
+		"user-2": {Id: "user-2", Name: "Grace"},
+		"user-3": {Id: "user-3", Name: "Linus"},
+	}}
+	notifications := &stubNotificationClient{notifications: []*pb.Notification{{
+		Id:         "notification-1",
+		Type:       pb.NotificationType_NOTIFICATION_TYPE_LIKE,
+		PostId:     "post-1",
+		ActorIds:   []string{"user-3", "user-2", "user-gone"},
+		ActorCount: 3,
+		Message:    "Linus and 2 others liked your post",
+	}}}
+	resolver := graph.NewResolver(&stubPostClient{}, users, notifications)
+
+	resp := executeGraphQLWith(t, resolver, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `query { notifications { unreadCount edges { node { type postId actorCount message read actors { name } } } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	connection := resp["data"].(map[string]interface{})["notifications"].(map[string]interface{})
+	assert.Equal(t, float64(1), connection["unreadCount"])
+
+	edges := connection["edges"].([]interface{})
+	require.Len(t, edges, 1)
+	node := edges[0].(map[string]interface{})["node"].(map[string]interface{})
+	assert.Equal(t, "LIKE", node["type"])
+	assert.Equal(t, "post-1", node["postId"])
+	assert.Equal(t, float64(3), node["actorCount"])
+	assert.Equal(t, false, node["read"])
+
+	// Actors are fetched in one batch and deleted accounts are skipped
+	actors := node["actors"].([]interface{})
+	require.Len(t, actors, 2)
+	assert.Equal(t, "Linus", actors[0].(map[string]interface{})["name"])
+	assert.Equal(t, "Grace", actors[1].(map[string]interface{})["name"])
+	assert.Len(t, users.batches, 1)
+
+	resp = executeGraphQLWith(t, resolver, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { markNotificationsRead(ids: ["notification-1"]) }`,
+	})
+	require.Nil(t, resp["errors"])
+	assert.Equal(t, float64(0), resp["data"].(map[string]interface{})["markNotificationsRead"])
+	assert.Equal(t, [][]string{{"notification-1"}}, notifications.marked)
+
+	// Notifications are personal
+	resp = executeGraphQLWith(t, resolver, nil, map[string]interface{}{
+		"query": `query { unreadNotificationCount }`,
+	})
+	assert.NotNil(t, resp["errors"])
+}
+
+func TestGraphQL_UploadMedia(t *testing.T) {
+	client := &stubPostClient{}
+	resolver := graph.NewResolver(client, &stubUserClient{}, &stubNotificationClient{})
+
+	// Files larger than one chunk are streamed in pieces
+	file := bytes.Repeat([]byte("x"), 100<<10)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	require.NoError(t, form.WriteField("operations", `{"query": "mutation ($file: Upload!) { uploadMedia(file: $file) { id url size } }", "variables": {"file": null}}`))
+	require.NoError(t, form.WriteField("map", `{"0": ["variables.file"]}`))
+	part, err := form.CreateFormFile("0", "photo.png")
+	require.NoError(t, err)
+	_, err = part.Write(file)
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/query", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	resp := serveGraphQL(t, resolver, &auth.Claims{UserID: "user-1"}, req)
+	require.Nil(t, resp["errors"])
+
+	media := resp["data"].(map[string]interface{})["uploadMedia"].(map[string]interface{})
+	assert.Equal(t, "media-1", media["id"])
+	assert.Equal(t, float64(len(file)), media["size"])
+
+	require.NotNil(t, client.uploads)
+	assert.Equal(t, "photo.png", client.uploads.metadata.Filename)
+	assert.Equal(t, int64(len(file)), client.uploads.metadata.Size)
+	assert.Equal(t, file, client.uploads.data)
+
+	// Uploaded media is referenced by ID when posting
+	resp = executeGraphQLWith(t, resolver, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { createPost(content: "Look", mediaId: "media-1") { id } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.created, 1)
+	assert.Equal(t, "media-1", client.created[0].MediaId)
+}
+
+func TestGraphQL_PostMedia(t *testing.T) {
+	client := &stubPostClient{posts: []*pb.Post{{
+		Id:       "post-1",
+		ImageUrl: wrapperspb.String("http://media.test/media/1.jpg"),
+		Media: []*pb.PostMedia{
+			{Id: "attachment-1", Type: pb.PostMediaType_POST_MEDIA_TYPE_IMAGE, Url: "http://media.test/media/1.jpg", Width: 640, Height: 480, Alt: "A red bicycle", Blurhash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj"},
+			{Id: "attachment-2", Type: pb.PostMediaType_POST_MEDIA_TYPE_GIF, Url: "http://example.com/a.gif"},
+		},
+	}}}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query { getPosts { posts { imageUrl media { id type url width height alt blurhash } } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	post := resp["data"].(map[string]interface{})["getPosts"].(map[string]interface{})["posts"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "http://media.test/media/1.jpg", post["imageUrl"])
+
+	media := post["media"].([]interface{})
+	require.Len(t, media, 2)
+	first := media[0].(map[string]interface{})
+	assert.Equal(t, "IMAGE", first["type"])
+	assert.Equal(t, float64(640), first["width"])
+	assert.Equal(t, "A red bicycle", first["alt"])
+
+	// Sizes, alt text and blurhashes of linked images are unknown
+	second := media[1].(map[string]interface{})
+	assert.Equal(t, "GIF", second["type"])
+	assert.Nil(t, second["width"])
+	assert.Nil(t, second["alt"])
+	assert.Nil(t, second["blurhash"])
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { createPost(content: "Two photos", media: [{mediaId: "media-1", alt: "First"}, {mediaId: "media-2"}]) { id } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.created, 1)
+	require.Len(t, client.created[0].Media, 2)
+	assert.Equal(t, "media-1", client.created[0].Media[0].MediaId)
+	assert.Equal(t, "First", client.created[0].Media[0].Alt)
+	assert.Equal(t, "media-2", client.created[0].Media[1].MediaId)
+}
+
+func TestGraphQL_Reactions(t *testing.T) {
+	client := &stubPostClient{}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query { reactionTypes { type emoji } }`,
+	})
+	require.Nil(t, resp["errors"])
+	types := resp["data"].(map[string]interface{})["reactionTypes"].([]interface{})
+	require.Len(t, types, 2)
+	assert.Equal(t, map[string]interface{}{"type": "love", "emoji": "❤️"}, types[1])
+
+	// Reacting requires a signed in user
+	resp = executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `mutation { addReaction(postId: "post-1", type: "love") { id } }`,
+	})
+	require.NotNil(t, resp["errors"])
+	assert.Empty(t, client.reacted)
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { addReaction(postId: "post-1", type: "love") { likes reactions { type emoji count } viewerReactions } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.reacted, 1)
+	assert.Equal(t, "love", client.reacted[0].Type)
+
+	post := resp["data"].(map[string]interface{})["addReaction"].(map[string]interface{})
+	assert.Equal(t, float64(1), post["likes"])
+	assert.Equal(t, []interface{}{"love"}, post["viewerReactions"])
+	reactions := post["reactions"].([]interface{})
+	require.Len(t, reactions, 2)
+	assert.Equal(t, map[string]interface{}{"type": "love", "emoji": "❤️", "count": float64(2)}, reactions[1])
+}
+
+func TestGraphQL_RepostsAndQuotes(t *testing.T) {
+	client := &stubPostClient{posts: []*pb.Post{{Id: "post-1", Content: "Original", RepostCount: 1}}}
+
+	// Reposts come with the shared post embedded
+	resp := executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { repost(postId: "post-1") { id content repostOf { id content repostCount } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	repost := resp["data"].(map[string]interface{})["repost"].(map[string]interface{})
+	assert.Equal(t, "", repost["content"])
+	assert.Equal(t, map[string]interface{}{"id": "post-1", "content": "Original", "repostCount": float64(1)}, repost["repostOf"])
+	assert.Empty(t, client.fetched)
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { createPost(content: "So true", quotedPostId: "post-1") { id } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.created, 1)
+	assert.Equal(t, "post-1", client.created[0].QuotedPostId)
+
+	// Posts that only carry the ID are resolved, deleted ones are null
+	client.posts = append(client.posts,
+		&pb.Post{Id: "quote-1", Content: "So true", QuotedPostId: "post-1"},
+		&pb.Post{Id: "quote-2", Content: "Gone", QuotedPostId: "deleted-post"},
+	)
+	resp = executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query { a: getPostById(id: "quote-1") { quotedPost { content } } b: getPostById(id: "quote-2") { quotedPost { content } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"content": "Original"}, data["a"].(map[string]interface{})["quotedPost"])
+	assert.Nil(t, data["b"].(map[string]interface{})["quotedPost"])
+}
+
+func TestGraphQL_Threads(t *testing.T) {
+	client := &stubPostClient{}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { createPost(content: "Agreed", parentId: "post-1") { id } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.created, 1)
+	assert.Equal(t, "post-1", client.created[0].ParentId)
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query {
+			thread(postId: "post-1", depth: 2, first: 1) {
+				ancestors { id replyCount }
+				tree {
+					post { id parentId rootId replyCount }
+					pageInfo { hasNextPage endCursor }
+					replies { post { id parentId } replies { post { id } } pageInfo { hasNextPage } }
+				}
+			}
+		}`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.threads, 1)
+	assert.Equal(t, &pb.GetThreadRequest{PostId: "post-1", Depth: 2, First: 1}, client.threads[0])
+
+	thread := resp["data"].(map[string]interface{})["thread"].(map[string]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": "root-1", "replyCount": float64(1)}}, thread["ancestors"])
+	tree := thread["tree"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"id": "post-1", "parentId": "root-1", "rootId": "root-1", "replyCount": float64(3)}, tree["post"])
+	assert.Equal(t, map[string]interface{}{"hasNextPage": true, "endCursor": "cursor-1"}, tree["pageInfo"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"post":     map[string]interface{}{"id": "reply-1", "parentId": "post-1"},
+		"replies":  []interface{}{},
+		"pageInfo": map[string]interface{}{"hasNextPage": true},
+	}}, tree["replies"])
+}
+
+func TestGraphQL_Bookmarks(t *testing.T) {
+	client := &stubPostClient{posts: []*pb.Post{{Id: "post-2", Content: "Not saved"}}}
+
+	// Bookmarks belong to the signed in user
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query { bookmarks { edges { node { id } } } }`,
+	})
+	assert.NotNil(t, resp["errors"])
+	assert.Empty(t, client.bookmarks)
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { bookmark(postId: "post-1") { id viewerHasBookmarked } }`,
+	})
+	require.Nil(t, resp["errors"])
+	assert.Equal(t, map[string]interface{}{"id": "post-1", "viewerHasBookmarked": true}, resp["data"].(map[string]interface{})["bookmark"])
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `query { bookmarks(first: 5, after: "cursor-0") { edges { cursor node { id viewerHasBookmarked } } pageInfo { hasNextPage } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.bookmarks, 1)
+	assert.Equal(t, int32(5), client.bookmarks[0].First)
+	assert.Equal(t, "cursor-0", client.bookmarks[0].After)
+	bookmarks := resp["data"].(map[string]interface{})["bookmarks"].(map[string]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"cursor": "cursor-1",
+		"node":   map[string]interface{}{"id": "post-1", "viewerHasBookmarked": true},
+	}}, bookmarks["edges"])
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `query { getPostById(id: "post-2") { viewerHasBookmarked } }`,
+	})
+	require.Nil(t, resp["errors"])
+	assert.Equal(t, map[string]interface{}{"viewerHasBookmarked": false}, resp["data"].(map[string]interface{})["getPostById"])
+}
+
+func TestGraphQL_Moderation(t *testing.T) {
+	client := &stubPostClient{}
+	moderator := &auth.Claims{UserID: "mod-1", Role: auth.RoleModerator}
+
+	resp := executeGraphQL(t, client, &stubUserClient{}, nil, map[string]interface{}{
+		"query": `mutation { reportPost(postId: "post-1", reason: SPAM) { id } }`,
+	})
+	assert.NotNil(t, resp["errors"])
+	assert.Empty(t, client.reported)
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, &auth.Claims{UserID: "user-1"}, map[string]interface{}{
+		"query": `mutation { reportPost(postId: "post-1", reason: HARASSMENT, details: "Rude") { id reason details status decision } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.reported, 1)
+	assert.Equal(t, pb.ReportReason_REPORT_REASON_HARASSMENT, client.reported[0].Reason)
+	assert.Equal(t, map[string]interface{}{
+		"id":       "report-1",
+		"reason":   "HARASSMENT",
+		"details":  "Rude",
+		"status":   "OPEN",
+		"decision": nil,
+	}, resp["data"].(map[string]interface{})["reportPost"])
+
+	// The queue defaults to open reports
+	resp = executeGraphQL(t, client, &stubUserClient{}, moderator, map[string]interface{}{
+		"query": `query { reports(first: 5) { edges { cursor node { id reason post { content } } } pageInfo { hasNextPage } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.reports, 1)
+	assert.Equal(t, pb.ReportStatus_REPORT_STATUS_OPEN, client.reports[0].Status)
+	assert.Equal(t, int32(5), client.reports[0].First)
+	reports := resp["data"].(map[string]interface{})["reports"].(map[string]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"cursor": "cursor-1",
+		"node": map[string]interface{}{
+			"id":     "report-1",
+			"reason": "SPAM",
+			"post":   map[string]interface{}{"content": "Spam"},
+		},
+	}}, reports["edges"])
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, moderator, map[string]interface{}{
+		"query": `mutation { resolveReport(id: "report-1", decision: HIDE_POST, suspendAuthor: true, note: "spam") { status decision resolvedById } }`,
+	})
+	require.Nil(t, resp["errors"])
+	require.Len(t, client.resolved, 1)
+	assert.Equal(t, pb.ModerationDecision_MODERATION_DECISION_HIDE_POST, client.resolved[0].Decision)
+	assert.True(t, client.resolved[0].SuspendAuthor)
+	assert.Equal(t, "spam", client.resolved[0].Note)
+	assert.Equal(t, map[string]interface{}{
+		"status":       "RESOLVED",
+		"decision":     "HIDE_POST",
+		"resolvedById": "mod-1",
+	}, resp["data"].(map[string]interface{})["resolveReport"])
+
+	resp = executeGraphQL(t, client, &stubUserClient{}, moderator, map[string]interface{}{
+		"query": `query { moderationLog { edges { node { type moderatorId userId postId } } } }`,
+	})
+	require.Nil(t, resp["errors"])
+	log := resp["data"].(map[string]interface{})["moderationLog"].(map[string]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"node": map[string]interface{}{
+			"type":        "SUSPEND_AUTHOR",
+			"moderatorId": "mod-1",
+			"userId":      "user-2",
+			"postId":      nil,
+		},
+	}}, log["edges"])
+}
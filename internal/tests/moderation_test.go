@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"context"
+	"muze/internal/auth"
+	"muze/internal/cache"
+	"muze/internal/database"
+	"muze/internal/grpc"
+	"muze/internal/messaging"
+	pb "muze/proto"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func asModerator(userID string) context.Context {
+	return auth.ContextWithUser(context.Background(), &auth.Claims{UserID: userID, Role: auth.RoleModerator})
+}
+
+// findReport pages through the reports with the given status, oldest
+// first, until one matches
+func findReport(t *testing.T, server *grpc.PostServer, reportStatus pb.ReportStatus, match func(*pb.Report) bool) *pb.Report {
+	req := &pb.ListReportsRequest{Status: reportStatus, First: 100}
+	for {
+		page, err := server.ListReports(asModerator("mod1"), req)
+		require.NoError(t, err)
+		for _, edge := range page.Edges {
+			if match(edge.Report) {
+				return edge.Report
+			}
+		}
+		if !page.PageInfo.HasNextPage {
+			return nil
+		}
+		req.After = page.PageInfo.EndCursor
+	}
+}
+
+func TestAuth_ModeratorRole(t *testing.T) {
+	assert.True(t, (&auth.Claims{Role: auth.RoleModerator}).IsModerator())
+	assert.True(t, (&auth.Claims{Role: auth.RoleAdmin}).IsModerator())
+	assert.False(t, (&auth.Claims{}).IsModerator())
+	assert.False(t, (&auth.Claims{Role: auth.RoleModerator}).IsAdmin())
+}
+
+func TestPostService_ModerationRequiresModerator(t *testing.T) {
+	server := grpc.NewPostServer()
+
+	_, err := server.ReportPost(context.Background(), &pb.ReportPostRequest{PostId: "post-1", Reason: pb.ReportReason_REPORT_REASON_SPAM})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Reports need a reason and short details
+	_, err = server.ReportPost(asUser("user1"), &pb.ReportPostRequest{PostId: "post-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.ReportPost(asUser("user1"), &pb.ReportPostRequest{
+		PostId:  "post-1",
+		Reason:  pb.ReportReason_REPORT_REASON_OTHER,
+		Details: strings.Repeat("x", 1001),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only moderators and admins see the queue and make decisions
+	_, err = server.ListReports(context.Background(), &pb.ListReportsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.ListReports(asUser("user1"), &pb.ListReportsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ResolveReport(asUser("user1"), &pb.ResolveReportRequest{
+		ReportId: "report-1",
+		Decision: pb.ModerationDecision_MODERATION_DECISION_HIDE_POST,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ListModerationActions(asUser("user1"), &pb.ListModerationActionsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.ResolveReport(asModerator("mod1"), &pb.ResolveReportRequest{ReportId: "report-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.ResolveReport(asModerator("mod1"), &pb.ResolveReportRequest{
+		ReportId: "report-1",
+		Decision: pb.ModerationDecision_MODERATION_DECISION_DISMISS,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPostService_Moderation(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+	ctx := context.Background()
+
+	author := uuid.NewString()
+	post, err := server.CreatePost(asUser(author), &pb.CreatePostRequest{Content: "Buy followers now"})
+	require.NoError(t, err)
+	repost, err := server.Repost(asUser("user2"), &pb.RepostRequest{PostId: post.Id})
+	require.NoError(t, err)
+
+	// Reporting a repost reports the shared post, once per open report
+	report, err := server.ReportPost(asUser("user2"), &pb.ReportPostRequest{
+		PostId:  repost.Id,
+		Reason:  pb.ReportReason_REPORT_REASON_SPAM,
+		Details: " link farm ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, post.Id, report.PostId)
+	assert.Equal(t, "link farm", report.Details)
+	assert.Equal(t, pb.ReportStatus_REPORT_STATUS_OPEN, report.Status)
+	assert.Nil(t, report.Post)
+
+	_, err = server.ReportPost(asUser("user2"), &pb.ReportPostRequest{PostId: post.Id, Reason: pb.ReportReason_REPORT_REASON_SPAM})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = server.ReportPost(asUser(author), &pb.ReportPostRequest{PostId: post.Id, Reason: pb.ReportReason_REPORT_REASON_SPAM})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	second, err := server.ReportPost(asUser("user3"), &pb.ReportPostRequest{PostId: post.Id, Reason: pb.ReportReason_REPORT_REASON_OTHER})
+	require.NoError(t, err)
+
+	// The queue lists open reports with their post
+	queued := findReport(t, server, pb.ReportStatus_REPORT_STATUS_OPEN, func(r *pb.Report) bool { return r.Id == report.Id })
+	require.NotNil(t, queued)
+	require.NotNil(t, queued.Post)
+	assert.Equal(t, "Buy followers now", queued.Post.Content)
+
+	// Hiding resolves every open report of the post and suspends the author
+	resolved, err := server.ResolveReport(asModerator("mod1"), &pb.ResolveReportRequest{
+		ReportId:      report.Id,
+		Decision:      pb.ModerationDecision_MODERATION_DECISION_HIDE_POST,
+		SuspendAuthor: true,
+		Note:          "spam",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.ReportStatus_REPORT_STATUS_RESOLVED, resolved.Status)
+	assert.Equal(t, pb.ModerationDecision_MODERATION_DECISION_HIDE_POST, resolved.Decision)
+	assert.Equal(t, "mod1", resolved.ResolvedBy)
+
+	_, err = server.ResolveReport(asModerator("mod1"), &pb.ResolveReportRequest{
+		ReportId: second.Id,
+		Decision: pb.ModerationDecision_MODERATION_DECISION_DISMISS,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Hidden posts and their reposts are gone for everyone
+	_, err = server.GetPostById(ctx, &pb.GetPostByIdRequest{Id: post.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.GetPostById(ctx, &pb.GetPostByIdRequest{Id: repost.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	posts, err := server.GetPosts(ctx, &pb.GetPostsRequest{Limit: 100})
+	require.NoError(t, err)
+	for _, listed := range posts.Posts {
+		assert.NotEqual(t, post.Id, listed.Id)
+		assert.NotEqual(t, repost.Id, listed.Id)
+	}
+	_, err = server.LikePost(asUser("user3"), &pb.LikePostRequest{PostId: post.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.UnlikePost(asUser("user3"), &pb.UnlikePostRequest{PostId: post.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Suspended authors cannot write
+	_, err = server.CreatePost(asUser(author), &pb.CreatePostRequest{Content: "I'm back"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.UpdatePost(asUser(author), &pb.UpdatePostRequest{Id: post.Id, Content: wrapperspb.String("edited")})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Every decision is in the audit trail, newest first
+	log, err := server.ListModerationActions(asModerator("mod1"), &pb.ListModerationActionsRequest{First: 2})
+	require.NoError(t, err)
+	require.Len(t, log.Edges, 2)
+	var types []pb.ModerationActionType
+	for _, edge := range log.Edges {
+		assert.Equal(t, "mod1", edge.Action.ModeratorId)
+		assert.Equal(t, report.Id, edge.Action.ReportId)
+		assert.Equal(t, "spam", edge.Action.Note)
+		types = append(types, edge.Action.Type)
+	}
+	assert.ElementsMatch(t, []pb.ModerationActionType{
+		pb.ModerationActionType_MODERATION_ACTION_TYPE_HIDE_POST,
+		pb.ModerationActionType_MODERATION_ACTION_TYPE_SUSPEND_AUTHOR,
+	}, types)
+
+	for _, id := range []string{report.Id, second.Id} {
+		assert.NotNil(t, findReport(t, server, pb.ReportStatus_REPORT_STATUS_RESOLVED, func(r *pb.Report) bool { return r.Id == id }))
+	}
+}
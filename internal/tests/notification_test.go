@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"muze/internal/cache"
+	"muze/internal/database"
+	"muze/internal/grpc"
+	"muze/internal/messaging"
+	pb "muze/proto"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationService_RequiresAuthenticatedCaller(t *testing.T) {
+	server := grpc.NewNotificationServer()
+
+	_, err := server.ListNotifications(context.Background(), &pb.ListNotificationsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.MarkNotificationsRead(context.Background(), &pb.MarkNotificationsReadRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.GetUnreadCount(context.Background(), &pb.GetUnreadCountRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestNotificationService_AggregatesActivity(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	notifications := grpc.NewNotificationServer()
+	require.NoError(t, notifications.StartNotificationConsumer())
+
+	users := grpc.NewUserServer()
+	posts := grpc.NewPostServer()
+	author, ada, grace := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	for id, name := range map[string]string{author: "Author", ada: "Ada", grace: "Grace"} {
+		_, err := users.CreateUser(asUser(id), &pb.CreateUserRequest{Name: name})
+		require.NoError(t, err)
+	}
+
+	post, err := posts.CreatePost(asUser(author), &pb.CreatePostRequest{Content: "Notify me"})
+	require.NoError(t, err)
+
+	// Own likes are not notified and repeated likes count once
+	for _, liker := range []string{author, ada, grace} {
+		_, err = posts.LikePost(asUser(liker), &pb.LikePostRequest{PostId: post.Id})
+		require.NoError(t, err)
+	}
+	_, err = posts.UnlikePost(asUser(ada), &pb.UnlikePostRequest{PostId: post.Id})
+	require.NoError(t, err)
+	_, err = posts.LikePost(asUser(ada), &pb.LikePostRequest{PostId: post.Id})
+	require.NoError(t, err)
+
+	var list *pb.ListNotificationsResponse
+	require.Eventually(t, func() bool {
+		list, err = notifications.ListNotifications(asUser(author), &pb.ListNotificationsRequest{})
+		return err == nil && len(list.Edges) == 1 && list.Edges[0].Notification.ActorCount == 2
+	}, 5*time.Second, 50*time.Millisecond)
+
+	time.Sleep(200 * time.Millisecond)
+	list, err = notifications.ListNotifications(asUser(author), &pb.ListNotificationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Edges, 1)
+	notification := list.Edges[0].Notification
+	assert.Equal(t, pb.NotificationType_NOTIFICATION_TYPE_LIKE, notification.Type)
+	assert.Equal(t, post.Id, notification.PostId)
+	assert.Equal(t, int32(2), notification.ActorCount)
+	assert.Equal(t, []string{grace, ada}, notification.ActorIds)
+	assert.Equal(t, "Grace and Ada liked your post", notification.Message)
+	assert.Equal(t, int32(1), list.UnreadCount)
+
+	// Activity after reading starts a new group
+	unread, err := notifications.MarkNotificationsRead(asUser(author), &pb.MarkNotificationsReadRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), unread.UnreadCount)
+
+	_, err = users.Follow(asUser(ada), &pb.FollowRequest{UserId: author})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		count, err := notifications.GetUnreadCount(asUser(author), &pb.GetUnreadCountRequest{})
+		return err == nil && count.UnreadCount == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	list, err = notifications.ListNotifications(asUser(author), &pb.ListNotificationsRequest{First: 1})
+	require.NoError(t, err)
+	require.Len(t, list.Edges, 1)
+	assert.Equal(t, pb.NotificationType_NOTIFICATION_TYPE_FOLLOW, list.Edges[0].Notification.Type)
+	assert.Equal(t, "Ada followed you", list.Edges[0].Notification.Message)
+	assert.True(t, list.PageInfo.HasNextPage)
+
+	list, err = notifications.ListNotifications(asUser(author), &pb.ListNotificationsRequest{After: list.PageInfo.EndCursor})
+	require.NoError(t, err)
+	require.Len(t, list.Edges, 1)
+	assert.True(t, list.Edges[0].Notification.Read)
+}
@@ -2,6 +2,9 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"muze/internal/auth"
 	"muze/internal/cache"
 	"muze/internal/database"
 	"muze/internal/grpc"
@@ -9,13 +12,25 @@ import (
 	"muze/internal/models"
 	pb "muze/proto"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// asUser returns a context authenticated as the given user
+func asUser(userID string) context.Context {
+	return auth.ContextWithUser(context.Background(), &auth.Claims{UserID: userID})
+}
+
 func TestPostService_CreatePost(t *testing.T) {
 	// Skip if no database connection
 	if os.Getenv("DB_HOST") == "" {
@@ -28,7 +43,7 @@ func TestPostService_CreatePost(t *testing.T) {
 	messaging.InitNATS()
 
 	server := grpc.NewPostServer()
-	ctx := context.Background()
+	ctx := asUser("test-user-123")
 
 	// Test data
 	req := &pb.CreatePostRequest{
@@ -48,6 +63,73 @@ func TestPostService_CreatePost(t *testing.T) {
 	assert.NotEmpty(t, resp.Timestamp)
 }
 
+func TestPostService_RequiresAuthenticatedCaller(t *testing.T) {
+	server := grpc.NewPostServer()
+
+	// Anonymous callers cannot post or like
+	_, err := server.CreatePost(context.Background(), &pb.CreatePostRequest{Content: "anonymous"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.LikePost(context.Background(), &pb.LikePostRequest{PostId: "post-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Callers cannot act as someone else
+	_, err = server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "spoofed", AuthorId: "user2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.LikePost(asUser("user1"), &pb.LikePostRequest{PostId: "post-1", UserId: "user2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.UpdatePost(context.Background(), &pb.UpdatePostRequest{Id: "post-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.DeletePost(context.Background(), &pb.DeletePostRequest{Id: "post-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.UnlikePost(context.Background(), &pb.UnlikePostRequest{PostId: "post-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.UpdatePost(asUser("user1"), &pb.UpdatePostRequest{Id: "post-1", Content: wrapperspb.String("")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.CreateComment(context.Background(), &pb.CreateCommentRequest{PostId: "post-1", Content: "anonymous"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.DeleteComment(context.Background(), &pb.DeleteCommentRequest{Id: "comment-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.CreateComment(asUser("user1"), &pb.CreateCommentRequest{PostId: "post-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// IDs that are not UUIDs cannot name a post
+	_, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: "post-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.UpdatePost(asUser("user1"), &pb.UpdatePostRequest{Id: "post-1", Content: wrapperspb.String("Hi")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.DeletePost(asUser("user1"), &pb.DeletePostRequest{Id: "post-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.CreateComment(asUser("user1"), &pb.CreateCommentRequest{PostId: "post-1", Content: "Hi"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.ListComments(context.Background(), &pb.ListCommentsRequest{PostId: "post-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.Repost(context.Background(), &pb.RepostRequest{PostId: "post-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.UndoRepost(context.Background(), &pb.RepostRequest{PostId: "post-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.Bookmark(context.Background(), &pb.BookmarkRequest{PostId: "post-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.ListBookmarks(context.Background(), &pb.ListBookmarksRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
 func TestPostService_GetPosts(t *testing.T) {
 	// Skip if no database connection
 	if os.Getenv("DB_HOST") == "" {
@@ -72,8 +154,8 @@ func TestPostService_GetPosts(t *testing.T) {
 		AuthorId: "user2",
 	}
 
-	server.CreatePost(ctx, post1)
-	server.CreatePost(ctx, post2)
+	server.CreatePost(asUser("user1"), post1)
+	server.CreatePost(asUser("user2"), post2)
 
 	// Test get posts
 	req := &pb.GetPostsRequest{
@@ -89,7 +171,7 @@ func TestPostService_GetPosts(t *testing.T) {
 	assert.GreaterOrEqual(t, resp.Total, int32(2))
 }
 
-func TestPostService_LikePost(t *testing.T) {
+func TestPostService_GetPostsCursorPagination(t *testing.T) {
 	// Skip if no database connection
 	if os.Getenv("DB_HOST") == "" {
 		t.Skip("Skipping test - no database connection configured")
@@ -103,12 +185,65 @@ func TestPostService_LikePost(t *testing.T) {
 	server := grpc.NewPostServer()
 	ctx := context.Background()
 
+	// Create test posts
+	for i := 0; i < 3; i++ {
+		_, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{
+			Content:  "Paged test post",
+			AuthorId: "user1",
+		})
+		require.NoError(t, err)
+	}
+
+	// Walk the first pages and make sure no post repeats
+	first, err := server.GetPosts(ctx, &pb.GetPostsRequest{First: 2})
+	require.NoError(t, err)
+	require.Len(t, first.Edges, 2)
+	assert.True(t, first.PageInfo.HasNextPage)
+	assert.False(t, first.PageInfo.HasPreviousPage)
+	assert.Equal(t, first.Edges[1].Cursor, first.PageInfo.EndCursor)
+
+	// A post created between pages must not shift the next page
+	_, err = server.CreatePost(asUser("user2"), &pb.CreatePostRequest{
+		Content:  "Post created between pages",
+		AuthorId: "user2",
+	})
+	require.NoError(t, err)
+
+	second, err := server.GetPosts(ctx, &pb.GetPostsRequest{First: 2, After: first.PageInfo.EndCursor})
+	require.NoError(t, err)
+	require.NotEmpty(t, second.Edges)
+	assert.True(t, second.PageInfo.HasPreviousPage)
+
+	seen := map[string]bool{}
+	for _, edge := range append(first.Edges, second.Edges...) {
+		assert.False(t, seen[edge.Post.Id], "post %s returned twice", edge.Post.Id)
+		seen[edge.Post.Id] = true
+	}
+
+	// Malformed cursors are rejected
+	_, err = server.GetPosts(ctx, &pb.GetPostsRequest{First: 2, After: "not-a-cursor"})
+	assert.Error(t, err)
+}
+
+func TestPostService_LikePost(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
 	// Create a post first
 	createReq := &pb.CreatePostRequest{
 		Content:  "Post to like",
 		AuthorId: "user1",
 	}
-	post, err := server.CreatePost(ctx, createReq)
+	post, err := server.CreatePost(asUser("user1"), createReq)
 	require.NoError(t, err)
 
 	// Like the post
@@ -117,7 +252,7 @@ func TestPostService_LikePost(t *testing.T) {
 		UserId: "user2",
 	}
 
-	likedPost, err := server.LikePost(ctx, likeReq)
+	likedPost, err := server.LikePost(asUser("user2"), likeReq)
 
 	// Assert
 	require.NoError(t, err)
@@ -125,7 +260,725 @@ func TestPostService_LikePost(t *testing.T) {
 	assert.Equal(t, post.Id, likedPost.Id)
 }
 
-func TestCache_RecentPosts(t *testing.T) {
+func TestPostService_ConcurrentLikes(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	post, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Popular post"})
+	require.NoError(t, err)
+
+	// Every user likes twice at the same time
+	const users = 25
+	var wg sync.WaitGroup
+	var liked, duplicates atomic.Int32
+	for i := 0; i < users*2; i++ {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+			_, err := server.LikePost(asUser(userID), &pb.LikePostRequest{PostId: post.Id})
+			switch status.Code(err) {
+			case codes.OK:
+				liked.Add(1)
+			case codes.AlreadyExists:
+				duplicates.Add(1)
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(fmt.Sprintf("concurrent-user-%d", i%users))
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(users), liked.Load())
+	assert.Equal(t, int32(users), duplicates.Load())
+
+	cache.InvalidatePostCache(post.Id)
+	fetched, err := server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(users), fetched.Likes)
+
+	// Liking a missing post is reported as such
+	_, err = server.LikePost(asUser("user2"), &pb.LikePostRequest{PostId: "00000000-0000-0000-0000-000000000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPostService_UnlikePostIsIdempotent(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	post, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Post to unlike"})
+	require.NoError(t, err)
+
+	_, err = server.LikePost(asUser("user2"), &pb.LikePostRequest{PostId: post.Id})
+	require.NoError(t, err)
+
+	// Double taps leave the count at zero
+	for i := 0; i < 2; i++ {
+		unliked, err := server.UnlikePost(asUser("user2"), &pb.UnlikePostRequest{PostId: post.Id})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), unliked.Likes)
+	}
+
+	// The post can be liked again afterwards
+	liked, err := server.LikePost(asUser("user2"), &pb.LikePostRequest{PostId: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), liked.Likes)
+
+	_, err = server.UnlikePost(asUser("user2"), &pb.UnlikePostRequest{PostId: "00000000-0000-0000-0000-000000000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPostService_ReactionTypes(t *testing.T) {
+	server := grpc.NewPostServer()
+
+	_, err := server.AddReaction(context.Background(), &pb.ReactionRequest{PostId: "post-1", Type: "love"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.RemoveReaction(context.Background(), &pb.ReactionRequest{PostId: "post-1", Type: "love"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.AddReaction(asUser("user1"), &pb.ReactionRequest{PostId: "post-1", Type: "shrug"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// "like" is always available, even when the setting leaves it out
+	t.Setenv("REACTIONS", "Fire:🔥, clap:👏, fire:🔥")
+	server = grpc.NewPostServer()
+	resp, err := server.ListReactionTypes(context.Background(), &pb.ListReactionTypesRequest{})
+	require.NoError(t, err)
+	var types []string
+	for _, reaction := range resp.Types {
+		types = append(types, reaction.Type+reaction.Emoji)
+	}
+	assert.Equal(t, []string{"like👍", "fire🔥", "clap👏"}, types)
+
+	_, err = server.AddReaction(asUser("user1"), &pb.ReactionRequest{PostId: "post-1", Type: "love"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// IDs that are not UUIDs cannot name a post
+	_, err = server.AddReaction(asUser("user1"), &pb.ReactionRequest{PostId: "post-1", Type: "fire"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.RemoveReaction(asUser("user1"), &pb.ReactionRequest{PostId: "post-1", Type: "fire"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPostService_Reactions(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	post, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Post to react to"})
+	require.NoError(t, err)
+
+	// Likes are "like" reactions and users may add several types
+	_, err = server.LikePost(asUser("user2"), &pb.LikePostRequest{PostId: post.Id})
+	require.NoError(t, err)
+	_, err = server.AddReaction(asUser("user3"), &pb.ReactionRequest{PostId: post.Id, Type: "like"})
+	require.NoError(t, err)
+	reacted, err := server.AddReaction(asUser("user2"), &pb.ReactionRequest{PostId: post.Id, Type: "LOVE"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), reacted.Likes)
+	require.Len(t, reacted.Reactions, 2)
+	assert.Equal(t, "like", reacted.Reactions[0].Type)
+	assert.Equal(t, int32(2), reacted.Reactions[0].Count)
+	assert.Equal(t, "love", reacted.Reactions[1].Type)
+	assert.Equal(t, "❤️", reacted.Reactions[1].Emoji)
+	assert.Equal(t, []string{"like", "love"}, reacted.ViewerReactions)
+
+	_, err = server.AddReaction(asUser("user2"), &pb.ReactionRequest{PostId: post.Id, Type: "love"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = server.LikePost(asUser("user3"), &pb.LikePostRequest{PostId: post.Id})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Each viewer sees their own reactions, anonymous viewers none
+	fetched, err := server.GetPostById(asUser("user3"), &pb.GetPostByIdRequest{Id: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"like"}, fetched.ViewerReactions)
+	fetched, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: post.Id})
+	require.NoError(t, err)
+	assert.Empty(t, fetched.ViewerReactions)
+	assert.Len(t, fetched.Reactions, 2)
+
+	// Unliking removes the "like" reaction and removing twice is a no-op
+	unliked, err := server.UnlikePost(asUser("user2"), &pb.UnlikePostRequest{PostId: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), unliked.Likes)
+	assert.Equal(t, []string{"love"}, unliked.ViewerReactions)
+
+	for i := 0; i < 2; i++ {
+		removed, err := server.RemoveReaction(asUser("user2"), &pb.ReactionRequest{PostId: post.Id, Type: "love"})
+		require.NoError(t, err)
+		require.Len(t, removed.Reactions, 1)
+		assert.Equal(t, "like", removed.Reactions[0].Type)
+		assert.Empty(t, removed.ViewerReactions)
+	}
+
+	_, err = server.AddReaction(asUser("user2"), &pb.ReactionRequest{PostId: "00000000-0000-0000-0000-000000000000", Type: "love"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPostService_RepostsAndQuotes(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	original, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Worth sharing"})
+	require.NoError(t, err)
+
+	repost, err := server.Repost(asUser("user2"), &pb.RepostRequest{PostId: original.Id})
+	require.NoError(t, err)
+	assert.Equal(t, "user2", repost.AuthorId)
+	assert.Empty(t, repost.Content)
+	assert.Equal(t, original.Id, repost.RepostOfId)
+	require.NotNil(t, repost.RepostOf)
+	assert.Equal(t, "Worth sharing", repost.RepostOf.Content)
+	assert.Equal(t, int32(1), repost.RepostOf.RepostCount)
+
+	// A post is reposted once per user, and reposting a repost shares the
+	// original
+	_, err = server.Repost(asUser("user2"), &pb.RepostRequest{PostId: original.Id})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	again, err := server.Repost(asUser("user3"), &pb.RepostRequest{PostId: repost.Id})
+	require.NoError(t, err)
+	assert.Equal(t, original.Id, again.RepostOfId)
+	assert.Equal(t, int32(2), again.RepostOf.RepostCount)
+
+	_, err = server.UpdatePost(asUser("user2"), &pb.UpdatePostRequest{Id: repost.Id, Content: wrapperspb.String("edited")})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Quotes have their own content and embed the quoted post
+	quote, err := server.CreatePost(asUser("user3"), &pb.CreatePostRequest{Content: "So true", QuotedPostId: repost.Id})
+	require.NoError(t, err)
+	assert.Equal(t, original.Id, quote.QuotedPostId)
+	require.NotNil(t, quote.QuotedPost)
+	assert.Equal(t, "Worth sharing", quote.QuotedPost.Content)
+
+	_, err = server.CreatePost(asUser("user3"), &pb.CreatePostRequest{Content: "Hm", QuotedPostId: "00000000-0000-0000-0000-000000000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Undoing is idempotent and returns the original
+	for i := 0; i < 2; i++ {
+		undone, err := server.UndoRepost(asUser("user2"), &pb.RepostRequest{PostId: original.Id})
+		require.NoError(t, err)
+		assert.Equal(t, original.Id, undone.Id)
+		assert.Equal(t, int32(1), undone.RepostCount)
+	}
+	_, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: repost.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Deleting the original takes its reposts along; quotes stay without it
+	_, err = server.DeletePost(asUser("user1"), &pb.DeletePostRequest{Id: original.Id})
+	require.NoError(t, err)
+	_, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: again.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	fetched, err := server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: quote.Id})
+	require.NoError(t, err)
+	assert.Equal(t, original.Id, fetched.QuotedPostId)
+	assert.Nil(t, fetched.QuotedPost)
+}
+
+func TestPostService_Threads(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	root, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Thoughts?"})
+	require.NoError(t, err)
+
+	var replies []*pb.Post
+	for _, content := range []string{"First", "Second", "Third"} {
+		reply, err := server.CreatePost(asUser("user2"), &pb.CreatePostRequest{Content: content, ParentId: root.Id})
+		require.NoError(t, err)
+		assert.Equal(t, root.Id, reply.ParentId)
+		assert.Equal(t, root.Id, reply.RootId)
+		replies = append(replies, reply)
+	}
+
+	nested, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Agreed", ParentId: replies[0].Id})
+	require.NoError(t, err)
+	assert.Equal(t, replies[0].Id, nested.ParentId)
+	assert.Equal(t, root.Id, nested.RootId)
+	deepest, err := server.CreatePost(asUser("user2"), &pb.CreatePostRequest{Content: "Thanks", ParentId: nested.Id})
+	require.NoError(t, err)
+
+	_, err = server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Hm", ParentId: "00000000-0000-0000-0000-000000000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	fetched, err := server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: root.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), fetched.ReplyCount)
+
+	// Replies are listed oldest first, a page per post and depth levels deep
+	thread, err := server.GetThread(context.Background(), &pb.GetThreadRequest{PostId: root.Id, Depth: 2, First: 2})
+	require.NoError(t, err)
+	assert.Empty(t, thread.Ancestors)
+	assert.Equal(t, root.Id, thread.Thread.Post.Id)
+	require.Len(t, thread.Thread.Replies, 2)
+	assert.Equal(t, replies[0].Id, thread.Thread.Replies[0].Post.Id)
+	assert.Equal(t, replies[1].Id, thread.Thread.Replies[1].Post.Id)
+	assert.True(t, thread.Thread.PageInfo.HasNextPage)
+
+	first := thread.Thread.Replies[0]
+	require.Len(t, first.Replies, 1)
+	assert.Equal(t, nested.Id, first.Replies[0].Post.Id)
+	assert.Empty(t, first.Replies[0].Replies)
+	assert.True(t, first.Replies[0].PageInfo.HasNextPage)
+	assert.False(t, thread.Thread.Replies[1].PageInfo.HasNextPage)
+
+	// after pages through the replies to the requested post
+	next, err := server.GetThread(context.Background(), &pb.GetThreadRequest{PostId: root.Id, First: 2, After: thread.Thread.PageInfo.EndCursor})
+	require.NoError(t, err)
+	require.Len(t, next.Thread.Replies, 1)
+	assert.Equal(t, replies[2].Id, next.Thread.Replies[0].Post.Id)
+	assert.False(t, next.Thread.PageInfo.HasNextPage)
+	assert.True(t, next.Thread.PageInfo.HasPreviousPage)
+
+	// Threads read from a reply include the posts it answers
+	thread, err = server.GetThread(context.Background(), &pb.GetThreadRequest{PostId: deepest.Id})
+	require.NoError(t, err)
+	require.Len(t, thread.Ancestors, 3)
+	assert.Equal(t, root.Id, thread.Ancestors[0].Id)
+	assert.Equal(t, replies[0].Id, thread.Ancestors[1].Id)
+	assert.Equal(t, nested.Id, thread.Ancestors[2].Id)
+
+	_, err = server.GetThread(context.Background(), &pb.GetThreadRequest{PostId: root.Id, After: "not-a-cursor"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Deleting a reply updates its parent's count
+	_, err = server.DeletePost(asUser("user2"), &pb.DeletePostRequest{Id: replies[2].Id})
+	require.NoError(t, err)
+	fetched, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: root.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), fetched.ReplyCount)
+}
+
+func TestPostService_ThreadNodeCap(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	root, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Ask me anything"})
+	require.NoError(t, err)
+
+	// 100 replies, the first 60 of which have 3 replies each
+	var replies []*pb.Post
+	for i := 0; i < 100; i++ {
+		reply, err := server.CreatePost(asUser("user2"), &pb.CreatePostRequest{Content: fmt.Sprintf("Question %d", i), ParentId: root.Id})
+		require.NoError(t, err)
+		replies = append(replies, reply)
+	}
+	for i, reply := range replies[:60] {
+		for j := 0; j < 3; j++ {
+			_, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: fmt.Sprintf("Answer %d.%d", i, j), ParentId: reply.Id})
+			require.NoError(t, err)
+		}
+	}
+
+	// The tree stops at 200 replies, and the posts it cut off have a next page
+	thread, err := server.GetThread(context.Background(), &pb.GetThreadRequest{PostId: root.Id, First: 100, Depth: 3})
+	require.NoError(t, err)
+	require.Len(t, thread.Thread.Replies, 100)
+	assert.False(t, thread.Thread.PageInfo.HasNextPage)
+
+	total := len(thread.Thread.Replies)
+	for i, node := range thread.Thread.Replies {
+		total += len(node.Replies)
+		switch {
+		case i < 33:
+			assert.Len(t, node.Replies, 3)
+			assert.False(t, node.PageInfo.HasNextPage)
+		case i == 33:
+			assert.Len(t, node.Replies, 1)
+			assert.True(t, node.PageInfo.HasNextPage)
+		case i < 60:
+			assert.Empty(t, node.Replies)
+			assert.True(t, node.PageInfo.HasNextPage)
+		default:
+			assert.Empty(t, node.Replies)
+			assert.False(t, node.PageInfo.HasNextPage)
+		}
+	}
+	assert.Equal(t, 200, total)
+}
+
+func TestPostService_Bookmarks(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	var posts []*pb.Post
+	for _, content := range []string{"Read later", "Also good", "Recipe"} {
+		post, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: content})
+		require.NoError(t, err)
+		posts = append(posts, post)
+	}
+
+	for _, post := range posts {
+		saved, err := server.Bookmark(asUser("user2"), &pb.BookmarkRequest{PostId: post.Id})
+		require.NoError(t, err)
+		assert.True(t, saved.ViewerHasBookmarked)
+	}
+	_, err := server.Bookmark(asUser("user2"), &pb.BookmarkRequest{PostId: posts[0].Id})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Only the owner sees a bookmark
+	fetched, err := server.GetPostById(asUser("user2"), &pb.GetPostByIdRequest{Id: posts[0].Id})
+	require.NoError(t, err)
+	assert.True(t, fetched.ViewerHasBookmarked)
+	fetched, err = server.GetPostById(asUser("user1"), &pb.GetPostByIdRequest{Id: posts[0].Id})
+	require.NoError(t, err)
+	assert.False(t, fetched.ViewerHasBookmarked)
+	fetched, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: posts[0].Id})
+	require.NoError(t, err)
+	assert.False(t, fetched.ViewerHasBookmarked)
+
+	others, err := server.ListBookmarks(asUser("user1"), &pb.ListBookmarksRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, others.Edges)
+
+	// Most recently bookmarked first, paged by cursor
+	page, err := server.ListBookmarks(asUser("user2"), &pb.ListBookmarksRequest{First: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Edges, 2)
+	assert.Equal(t, posts[2].Id, page.Edges[0].Post.Id)
+	assert.Equal(t, posts[1].Id, page.Edges[1].Post.Id)
+	assert.True(t, page.PageInfo.HasNextPage)
+
+	next, err := server.ListBookmarks(asUser("user2"), &pb.ListBookmarksRequest{First: 2, After: page.PageInfo.EndCursor})
+	require.NoError(t, err)
+	require.Len(t, next.Edges, 1)
+	assert.Equal(t, posts[0].Id, next.Edges[0].Post.Id)
+	assert.False(t, next.PageInfo.HasNextPage)
+
+	// Removing a bookmark is idempotent and deleted posts drop out
+	for i := 0; i < 2; i++ {
+		removed, err := server.Unbookmark(asUser("user2"), &pb.BookmarkRequest{PostId: posts[1].Id})
+		require.NoError(t, err)
+		assert.False(t, removed.ViewerHasBookmarked)
+	}
+	_, err = server.DeletePost(asUser("user1"), &pb.DeletePostRequest{Id: posts[2].Id})
+	require.NoError(t, err)
+
+	page, err = server.ListBookmarks(asUser("user2"), &pb.ListBookmarksRequest{})
+	require.NoError(t, err)
+	require.Len(t, page.Edges, 1)
+	assert.Equal(t, posts[0].Id, page.Edges[0].Post.Id)
+}
+
+func TestPostService_UpdateAndDeletePost(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	post, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{
+		Content:  "Post with a tpyo",
+		ImageUrl: wrapperspb.String("https://example.com/a.png"),
+	})
+	require.NoError(t, err)
+
+	// Only the author can edit
+	_, err = server.UpdatePost(asUser("user2"), &pb.UpdatePostRequest{Id: post.Id, Content: wrapperspb.String("hijacked")})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	updated, err := server.UpdatePost(asUser("user1"), &pb.UpdatePostRequest{
+		Id:       post.Id,
+		Content:  wrapperspb.String("Post without a typo"),
+		ImageUrl: wrapperspb.String(""),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Post without a typo", updated.Content)
+	assert.Nil(t, updated.ImageUrl)
+
+	fetched, err := server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, "Post without a typo", fetched.Content)
+
+	// Other users cannot delete, admins can
+	_, err = server.DeletePost(asUser("user2"), &pb.DeletePostRequest{Id: post.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	admin := auth.ContextWithUser(context.Background(), &auth.Claims{UserID: "admin", Role: auth.RoleAdmin})
+	deleted, err := server.DeletePost(admin, &pb.DeletePostRequest{Id: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, post.Id, deleted.Id)
+
+	_, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: post.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPostService_Comments(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	server := grpc.NewPostServer()
+
+	post, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Discuss"})
+	require.NoError(t, err)
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		comment, err := server.CreateComment(asUser("user2"), &pb.CreateCommentRequest{
+			PostId:  post.Id,
+			Content: fmt.Sprintf("Comment %d", i),
+		})
+		require.NoError(t, err)
+		ids = append(ids, comment.Id)
+	}
+
+	fetched, err := server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), fetched.CommentCount)
+
+	// Comments are listed oldest first
+	page, err := server.ListComments(context.Background(), &pb.ListCommentsRequest{PostId: post.Id, First: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Edges, 2)
+	assert.Equal(t, ids[0], page.Edges[0].Comment.Id)
+	assert.True(t, page.PageInfo.HasNextPage)
+
+	page, err = server.ListComments(context.Background(), &pb.ListCommentsRequest{PostId: post.Id, First: 2, After: page.PageInfo.EndCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Edges, 1)
+	assert.Equal(t, ids[2], page.Edges[0].Comment.Id)
+	assert.False(t, page.PageInfo.HasNextPage)
+
+	// First pages of several posts come in one call
+	other, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{Content: "Quiet"})
+	require.NoError(t, err)
+	batch, err := server.ListCommentsByPosts(context.Background(), &pb.ListCommentsByPostsRequest{
+		PostIds: []string{post.Id, other.Id, "post-1"},
+		First:   2,
+	})
+	require.NoError(t, err)
+	require.Len(t, batch.Pages, 2)
+	require.Len(t, batch.Pages[post.Id].Edges, 2)
+	assert.Equal(t, ids[0], batch.Pages[post.Id].Edges[0].Comment.Id)
+	assert.True(t, batch.Pages[post.Id].PageInfo.HasNextPage)
+	assert.Empty(t, batch.Pages[other.Id].Edges)
+
+	// Strangers cannot delete comments, the post author can
+	_, err = server.DeleteComment(asUser("user3"), &pb.DeleteCommentRequest{Id: ids[0]})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.DeleteComment(asUser("user1"), &pb.DeleteCommentRequest{Id: ids[0]})
+	require.NoError(t, err)
+
+	fetched, err = server.GetPostById(context.Background(), &pb.GetPostByIdRequest{Id: post.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), fetched.CommentCount)
+
+	page, err = server.ListComments(context.Background(), &pb.ListCommentsRequest{PostId: post.Id})
+	require.NoError(t, err)
+	assert.Len(t, page.Edges, 2)
+}
+
+func TestPostService_SearchPosts(t *testing.T) {
+	server := grpc.NewPostServer()
+
+	// Queries without words are rejected before reaching the database
+	_, err := server.SearchPosts(context.Background(), &pb.SearchPostsRequest{Query: ` "" * & | `})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+	server = grpc.NewPostServer()
+
+	// A unique word keeps other test data out of the results
+	tag := fmt.Sprintf("zq%d", time.Now().UnixNano())
+	ctx := asUser("searcher")
+	best, err := server.CreatePost(ctx, &pb.CreatePostRequest{Content: tag + " quick brown fox, " + tag + " again <b>"})
+	require.NoError(t, err)
+	other, err := server.CreatePost(ctx, &pb.CreatePostRequest{Content: "the fox is quick and brown " + tag})
+	require.NoError(t, err)
+	deleted, err := server.CreatePost(ctx, &pb.CreatePostRequest{Content: "quick brown fox " + tag})
+	require.NoError(t, err)
+	_, err = server.DeletePost(ctx, &pb.DeletePostRequest{Id: deleted.Id})
+	require.NoError(t, err)
+
+	// Phrases match adjacent words only, deleted posts never match
+	resp, err := server.SearchPosts(context.Background(), &pb.SearchPostsRequest{Query: `"quick brown" ` + tag})
+	require.NoError(t, err)
+	require.Len(t, resp.Edges, 1)
+	assert.Equal(t, best.Id, resp.Edges[0].Post.Id)
+	assert.Contains(t, resp.Edges[0].Snippet, "<mark>")
+	assert.Contains(t, resp.Edges[0].Snippet, "&lt;b&gt;")
+
+	// Prefixes match, better matches rank first and pages follow the ranking
+	resp, err = server.SearchPosts(context.Background(), &pb.SearchPostsRequest{Query: tag[:len(tag)-2] + "* fox", First: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Edges, 1)
+	assert.Equal(t, best.Id, resp.Edges[0].Post.Id)
+	assert.True(t, resp.PageInfo.HasNextPage)
+
+	resp, err = server.SearchPosts(context.Background(), &pb.SearchPostsRequest{Query: tag[:len(tag)-2] + "* fox", First: 1, After: resp.PageInfo.EndCursor})
+	require.NoError(t, err)
+	require.Len(t, resp.Edges, 1)
+	assert.Equal(t, other.Id, resp.Edges[0].Post.Id)
+	assert.False(t, resp.PageInfo.HasNextPage)
+}
+
+func TestPostService_HashtagsAndMentions(t *testing.T) {
+	server := grpc.NewPostServer()
+
+	_, err := server.PostsByHashtag(context.Background(), &pb.PostsByHashtagRequest{Tag: " # "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	// Setup
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+	server = grpc.NewPostServer()
+
+	// Names are unique per run since mentions resolve to the oldest match
+	mentionedID := uuid.NewString()
+	name := fmt.Sprintf("mentioned%d", time.Now().UnixNano())
+	_, err = grpc.NewUserServer().CreateUser(asUser(mentionedID), &pb.CreateUserRequest{Name: name})
+	require.NoError(t, err)
+
+	mentions := make(chan messaging.PostMentionedEvent, 4)
+	sub, err := messaging.SubscribeToPostEvents(func(subject string, data []byte) {
+		var event messaging.PostMentionedEvent
+		if subject == messaging.SubjectPostMentioned && json.Unmarshal(data, &event) == nil {
+			mentions <- event
+		}
+	})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	tag := fmt.Sprintf("Tag%d", time.Now().UnixNano())
+	post, err := server.CreatePost(asUser("user1"), &pb.CreatePostRequest{
+		Content: "Hi @" + name + " and @nobody, see #" + tag + " and #" + tag + " (not#this, #123, #" + strings.Repeat("long", 17) + ")",
+	})
+	require.NoError(t, err)
+
+	// Unknown users, hashtags without letters and words over 64 characters
+	// are not entities
+	require.Len(t, post.Entities, 3)
+	assert.Equal(t, pb.PostEntityType_POST_ENTITY_TYPE_MENTION, post.Entities[0].Type)
+	assert.Equal(t, mentionedID, post.Entities[0].UserId)
+	assert.Equal(t, int32(3), post.Entities[0].Start)
+	assert.Equal(t, int32(4+len(name)), post.Entities[0].End)
+	assert.Equal(t, pb.PostEntityType_POST_ENTITY_TYPE_HASHTAG, post.Entities[1].Type)
+	assert.Equal(t, tag, post.Entities[1].Text)
+
+	select {
+	case event := <-mentions:
+		assert.Equal(t, post.Id, event.PostID)
+		assert.Equal(t, mentionedID, event.UserID)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a post.mentioned event")
+	}
+
+	// Tags are matched case-insensitively, with or without the #
+	feed, err := server.PostsByHashtag(context.Background(), &pb.PostsByHashtagRequest{Tag: "#" + strings.ToLower(tag)})
+	require.NoError(t, err)
+	require.Len(t, feed.Edges, 1)
+	assert.Equal(t, post.Id, feed.Edges[0].Post.Id)
+
+	// Edits re-extract entities and do not notify users mentioned before
+	_, err = server.UpdatePost(asUser("user1"), &pb.UpdatePostRequest{
+		Id:      post.Id,
+		Content: wrapperspb.String("Still @" + strings.ToUpper(name) + ", no tags"),
+	})
+	require.NoError(t, err)
+
+	feed, err = server.PostsByHashtag(context.Background(), &pb.PostsByHashtagRequest{Tag: tag})
+	require.NoError(t, err)
+	assert.Empty(t, feed.Edges)
+
+	select {
+	case event := <-mentions:
+		t.Fatalf("unexpected post.mentioned event for %s", event.UserID)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestCache_FeedWindow(t *testing.T) {
 	// Skip if no Redis connection
 	if os.Getenv("REDIS_HOST") == "" {
 		t.Skip("Skipping test - no Redis connection configured")
@@ -134,36 +987,132 @@ func TestCache_RecentPosts(t *testing.T) {
 	// Setup
 	cache.InitRedis()
 
-	// Test data
+	// Test data, newest first
+	now := time.Now()
 	posts := []models.Post{
-		{
-			ID:         "1",
-			Content:    "First post",
-			AuthorID:   "user1",
-			AuthorName: "User 1",
-			Likes:      5,
-			CreatedAt:  time.Now(),
-		},
 		{
 			ID:         "2",
 			Content:    "Second post",
 			AuthorID:   "user2",
 			AuthorName: "User 2",
 			Likes:      10,
-			CreatedAt:  time.Now(),
+			CreatedAt:  now,
+		},
+		{
+			ID:         "1",
+			Content:    "First post",
+			AuthorID:   "user1",
+			AuthorName: "User 1",
+			Likes:      5,
+			CreatedAt:  now.Add(-time.Minute),
 		},
 	}
 
-	// Test cache
-	err := cache.CacheRecentPosts(posts)
+	// Test cache with a window holding every post
+	err := cache.CacheFeed(posts, 2)
+	require.NoError(t, err)
+
+	// Retrieve pages from cache
+	ids, total, err := cache.GetFeedPage(0, 10)
 	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "1"}, ids)
+	assert.Equal(t, int64(2), total)
+
+	ids, _, err = cache.GetFeedPage(1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, ids)
+
+	// New posts are added to the head incrementally
+	err = cache.AddToFeed(models.Post{ID: "3", CreatedAt: now.Add(time.Minute)})
+	require.NoError(t, err)
+
+	ids, total, err = cache.GetFeedPage(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3"}, ids)
+	assert.Equal(t, int64(3), total)
+
+	// A window that does not hold every post cannot answer deeper pages
+	err = cache.CacheFeed(posts, 100)
+	require.NoError(t, err)
+
+	_, _, err = cache.GetFeedPage(1, 10)
+	assert.ErrorIs(t, err, cache.ErrFeedMiss)
 
-	// Retrieve from cache
-	cachedPosts, err := cache.GetRecentPosts()
+	// Posts in the window hydrate in batch
+	found, missing, err := cache.GetCachedPosts([]string{"2", "does-not-exist"})
+	require.NoError(t, err)
+	assert.Equal(t, "Second post", found["2"].Content)
+	assert.Equal(t, []string{"does-not-exist"}, missing)
+
+	require.NoError(t, cache.InvalidateFeed())
+}
+
+func TestCache_Timeline(t *testing.T) {
+	// Skip if no Redis connection
+	if os.Getenv("REDIS_HOST") == "" {
+		t.Skip("Skipping test - no Redis connection configured")
+	}
+
+	// Setup
+	cache.InitRedis()
+	userID := fmt.Sprintf("timeline-user-%d", time.Now().UnixNano())
+	defer cache.InvalidateTimeline(userID)
+
+	// Timelines that were never built are misses, pushes do not build them
+	_, err := cache.GetTimelinePage(userID, time.Time{}, "", 10)
+	assert.Equal(t, cache.ErrTimelineMiss, err)
+
+	now := time.Now().Truncate(time.Microsecond)
+	require.NoError(t, cache.PushToTimelines(cache.TimelineEntry{PostID: "x", CreatedAt: now}, []string{userID}))
+	_, err = cache.GetTimelinePage(userID, time.Time{}, "", 10)
+	assert.Equal(t, cache.ErrTimelineMiss, err)
+
+	// Built timelines page newest first, ties ordered by ID descending
+	require.NoError(t, cache.RebuildTimeline(userID, []cache.TimelineEntry{
+		{PostID: "b", CreatedAt: now},
+		{PostID: "a", CreatedAt: now},
+		{PostID: "c", CreatedAt: now.Add(-time.Minute)},
+	}))
+	require.NoError(t, cache.PushToTimelines(cache.TimelineEntry{PostID: "d", CreatedAt: now.Add(time.Minute)}, []string{userID}))
+
+	page, err := cache.GetTimelinePage(userID, time.Time{}, "", 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "d", page[0].PostID)
+	assert.Equal(t, "b", page[1].PostID)
+
+	page, err = cache.GetTimelinePage(userID, page[1].CreatedAt, page[1].PostID, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "a", page[0].PostID)
+	assert.Equal(t, "c", page[1].PostID)
+
+	// Deleted posts leave the timeline
+	require.NoError(t, cache.RemoveFromTimelines("d", []string{userID}))
+	page, err = cache.GetTimelinePage(userID, time.Time{}, "", 10)
+	require.NoError(t, err)
+	assert.Len(t, page, 3)
+
+	// Invalidated timelines are rebuilt by the reader
+	require.NoError(t, cache.InvalidateTimeline(userID))
+	_, err = cache.GetTimelinePage(userID, time.Time{}, "", 10)
+	assert.Equal(t, cache.ErrTimelineMiss, err)
+
+	// Large accounts can return to fan-out-on-write, once
+	require.NoError(t, cache.MarkLargeAccount(userID))
+	large, err := cache.FilterLargeAccounts([]string{userID, "someone-else"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{userID}, large)
+
+	unmarked, err := cache.UnmarkLargeAccount(userID)
+	require.NoError(t, err)
+	assert.True(t, unmarked)
+	unmarked, err = cache.UnmarkLargeAccount(userID)
+	require.NoError(t, err)
+	assert.False(t, unmarked)
+	large, err = cache.FilterLargeAccounts([]string{userID})
 	require.NoError(t, err)
-	assert.Len(t, cachedPosts, 2)
-	assert.Equal(t, posts[0].ID, cachedPosts[0].ID)
-	assert.Equal(t, posts[1].ID, cachedPosts[1].ID)
+	assert.Empty(t, large)
 }
 
 func TestNATS_Messaging(t *testing.T) {
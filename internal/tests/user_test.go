@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"muze/internal/cache"
+	"muze/internal/database"
+	"muze/internal/grpc"
+	"muze/internal/messaging"
+	pb "muze/proto"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUserService_RequiresAuthenticatedCaller(t *testing.T) {
+	server := grpc.NewUserServer()
+
+	_, err := server.CreateUser(context.Background(), &pb.CreateUserRequest{Name: "anonymous"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.UpdateProfile(context.Background(), &pb.UpdateProfileRequest{Name: wrapperspb.String("anonymous")})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Profiles are keyed by the UUID in the token
+	_, err = server.CreateUser(asUser("user1"), &pb.CreateUserRequest{Name: "Ada"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.CreateUser(asUser(uuid.NewString()), &pb.CreateUserRequest{Name: "  "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GetUsersByIds(context.Background(), &pb.GetUsersByIdsRequest{Ids: make([]string, 101)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.Follow(context.Background(), &pb.FollowRequest{UserId: "user2"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.Unfollow(context.Background(), &pb.FollowRequest{UserId: "user2"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.Follow(asUser("user1"), &pb.FollowRequest{UserId: "user1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = grpc.NewPostServer().HomeFeed(context.Background(), &pb.HomeFeedRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestUserService_Profiles(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	database.InitDB()
+
+	server := grpc.NewUserServer()
+	userID := uuid.NewString()
+	ctx := asUser(userID)
+
+	created, err := server.CreateUser(ctx, &pb.CreateUserRequest{Name: "Ada", Bio: wrapperspb.String("Hello")})
+	require.NoError(t, err)
+	assert.Equal(t, userID, created.Id)
+	assert.Equal(t, "Hello", created.Bio.GetValue())
+
+	_, err = server.CreateUser(ctx, &pb.CreateUserRequest{Name: "Ada"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Only supplied fields change and empty values clear them
+	updated, err := server.UpdateProfile(ctx, &pb.UpdateProfileRequest{
+		Avatar: wrapperspb.String("https://example.com/ada.png"),
+		Bio:    wrapperspb.String(""),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", updated.Name)
+	assert.Equal(t, "https://example.com/ada.png", updated.Avatar.GetValue())
+	assert.Nil(t, updated.Bio)
+
+	_, err = server.GetUser(context.Background(), &pb.GetUserRequest{Id: "not-a-uuid"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := server.GetUsersByIds(context.Background(), &pb.GetUsersByIdsRequest{
+		Ids: []string{userID, uuid.NewString(), "not-a-uuid"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 1)
+	assert.Equal(t, userID, resp.Users[0].Id)
+}
+
+func TestUserService_FollowGraphAndHomeFeed(t *testing.T) {
+	// Skip if no database connection
+	if os.Getenv("DB_HOST") == "" {
+		t.Skip("Skipping test - no database connection configured")
+	}
+
+	database.InitDB()
+	cache.InitRedis()
+	messaging.InitNATS()
+
+	users := grpc.NewUserServer()
+	posts := grpc.NewPostServer()
+	reader, followed, stranger := uuid.NewString(), uuid.NewString(), uuid.NewString()
+
+	_, err := users.CreateUser(asUser(followed), &pb.CreateUserRequest{Name: "Followed"})
+	require.NoError(t, err)
+
+	_, err = users.Follow(asUser(reader), &pb.FollowRequest{UserId: followed})
+	require.NoError(t, err)
+
+	_, err = users.Follow(asUser(reader), &pb.FollowRequest{UserId: followed})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	profile, err := users.GetUser(context.Background(), &pb.GetUserRequest{Id: followed})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), profile.FollowerCount)
+
+	// Readers without a profile are listed by ID
+	followers, err := users.ListFollowers(context.Background(), &pb.ListFollowsRequest{UserId: followed})
+	require.NoError(t, err)
+	require.Len(t, followers.Edges, 1)
+	assert.Equal(t, reader, followers.Edges[0].User.Id)
+
+	following, err := users.ListFollowing(context.Background(), &pb.ListFollowsRequest{UserId: reader})
+	require.NoError(t, err)
+	require.Len(t, following.Edges, 1)
+	assert.Equal(t, "Followed", following.Edges[0].User.Name)
+
+	// Only followed authors show up in the home feed
+	wanted, err := posts.CreatePost(asUser(followed), &pb.CreatePostRequest{Content: "For my followers"})
+	require.NoError(t, err)
+	_, err = posts.CreatePost(asUser(stranger), &pb.CreatePostRequest{Content: "Not followed"})
+	require.NoError(t, err)
+
+	feed, err := posts.HomeFeed(asUser(reader), &pb.HomeFeedRequest{})
+	require.NoError(t, err)
+	require.Len(t, feed.Edges, 1)
+	assert.Equal(t, wanted.Id, feed.Edges[0].Post.Id)
+
+	// Unfollowing empties the feed and can be repeated
+	for i := 0; i < 2; i++ {
+		_, err = users.Unfollow(asUser(reader), &pb.FollowRequest{UserId: followed})
+		require.NoError(t, err)
+	}
+
+	feed, err = posts.HomeFeed(asUser(reader), &pb.HomeFeedRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, feed.Edges)
+}
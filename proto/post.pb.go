@@ -22,34 +22,3482 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PostMediaType int32
+
+const (
+	PostMediaType_POST_MEDIA_TYPE_UNSPECIFIED PostMediaType = 0
+	PostMediaType_POST_MEDIA_TYPE_IMAGE       PostMediaType = 1
+	PostMediaType_POST_MEDIA_TYPE_GIF         PostMediaType = 2
+)
+
+// Enum value maps for PostMediaType.
+var (
+	PostMediaType_name = map[int32]string{
+		0: "POST_MEDIA_TYPE_UNSPECIFIED",
+		1: "POST_MEDIA_TYPE_IMAGE",
+		2: "POST_MEDIA_TYPE_GIF",
+	}
+	PostMediaType_value = map[string]int32{
+		"POST_MEDIA_TYPE_UNSPECIFIED": 0,
+		"POST_MEDIA_TYPE_IMAGE":       1,
+		"POST_MEDIA_TYPE_GIF":         2,
+	}
+)
+
+func (x PostMediaType) Enum() *PostMediaType {
+	p := new(PostMediaType)
+	*p = x
+	return p
+}
+
+func (x PostMediaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostMediaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_proto_enumTypes[0].Descriptor()
+}
+
+func (PostMediaType) Type() protoreflect.EnumType {
+	return &file_proto_post_proto_enumTypes[0]
+}
+
+func (x PostMediaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostMediaType.Descriptor instead.
+func (PostMediaType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{0}
+}
+
+type PostEntityType int32
+
+const (
+	PostEntityType_POST_ENTITY_TYPE_UNSPECIFIED PostEntityType = 0
+	PostEntityType_POST_ENTITY_TYPE_HASHTAG     PostEntityType = 1
+	PostEntityType_POST_ENTITY_TYPE_MENTION     PostEntityType = 2
+)
+
+// Enum value maps for PostEntityType.
+var (
+	PostEntityType_name = map[int32]string{
+		0: "POST_ENTITY_TYPE_UNSPECIFIED",
+		1: "POST_ENTITY_TYPE_HASHTAG",
+		2: "POST_ENTITY_TYPE_MENTION",
+	}
+	PostEntityType_value = map[string]int32{
+		"POST_ENTITY_TYPE_UNSPECIFIED": 0,
+		"POST_ENTITY_TYPE_HASHTAG":     1,
+		"POST_ENTITY_TYPE_MENTION":     2,
+	}
+)
+
+func (x PostEntityType) Enum() *PostEntityType {
+	p := new(PostEntityType)
+	*p = x
+	return p
+}
+
+func (x PostEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_proto_enumTypes[1].Descriptor()
+}
+
+func (PostEntityType) Type() protoreflect.EnumType {
+	return &file_proto_post_proto_enumTypes[1]
+}
+
+func (x PostEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostEntityType.Descriptor instead.
+func (PostEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{1}
+}
+
+type PostEventType int32
+
+const (
+	PostEventType_POST_EVENT_TYPE_UNSPECIFIED PostEventType = 0
+	PostEventType_POST_EVENT_TYPE_CREATED     PostEventType = 1
+	PostEventType_POST_EVENT_TYPE_LIKED       PostEventType = 2
+	PostEventType_POST_EVENT_TYPE_UPDATED     PostEventType = 3
+	PostEventType_POST_EVENT_TYPE_DELETED     PostEventType = 4
+	PostEventType_POST_EVENT_TYPE_UNLIKED     PostEventType = 5
+	PostEventType_POST_EVENT_TYPE_COMMENTED   PostEventType = 6
+	PostEventType_POST_EVENT_TYPE_REACTED     PostEventType = 7
+	PostEventType_POST_EVENT_TYPE_UNREACTED   PostEventType = 8
+	PostEventType_POST_EVENT_TYPE_REPOSTED    PostEventType = 9
+)
+
+// Enum value maps for PostEventType.
+var (
+	PostEventType_name = map[int32]string{
+		0: "POST_EVENT_TYPE_UNSPECIFIED",
+		1: "POST_EVENT_TYPE_CREATED",
+		2: "POST_EVENT_TYPE_LIKED",
+		3: "POST_EVENT_TYPE_UPDATED",
+		4: "POST_EVENT_TYPE_DELETED",
+		5: "POST_EVENT_TYPE_UNLIKED",
+		6: "POST_EVENT_TYPE_COMMENTED",
+		7: "POST_EVENT_TYPE_REACTED",
+		8: "POST_EVENT_TYPE_UNREACTED",
+		9: "POST_EVENT_TYPE_REPOSTED",
+	}
+	PostEventType_value = map[string]int32{
+		"POST_EVENT_TYPE_UNSPECIFIED": 0,
+		"POST_EVENT_TYPE_CREATED":     1,
+		"POST_EVENT_TYPE_LIKED":       2,
+		"POST_EVENT_TYPE_UPDATED":     3,
+		"POST_EVENT_TYPE_DELETED":     4,
+		"POST_EVENT_TYPE_UNLIKED":     5,
+		"POST_EVENT_TYPE_COMMENTED":   6,
+		"POST_EVENT_TYPE_REACTED":     7,
+		"POST_EVENT_TYPE_UNREACTED":   8,
+		"POST_EVENT_TYPE_REPOSTED":    9,
+	}
+)
+
+func (x PostEventType) Enum() *PostEventType {
+	p := new(PostEventType)
+	*p = x
+	return p
+}
+
+func (x PostEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_proto_enumTypes[2].Descriptor()
+}
+
+func (PostEventType) Type() protoreflect.EnumType {
+	return &file_proto_post_proto_enumTypes[2]
+}
+
+func (x PostEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostEventType.Descriptor instead.
+func (PostEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{2}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_UNSPECIFIED    ReportReason = 0
+	ReportReason_REPORT_REASON_SPAM           ReportReason = 1
+	ReportReason_REPORT_REASON_HARASSMENT     ReportReason = 2
+	ReportReason_REPORT_REASON_HATE           ReportReason = 3
+	ReportReason_REPORT_REASON_VIOLENCE       ReportReason = 4
+	ReportReason_REPORT_REASON_NUDITY         ReportReason = 5
+	ReportReason_REPORT_REASON_MISINFORMATION ReportReason = 6
+	ReportReason_REPORT_REASON_OTHER          ReportReason = 7
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_UNSPECIFIED",
+		1: "REPORT_REASON_SPAM",
+		2: "REPORT_REASON_HARASSMENT",
+		3: "REPORT_REASON_HATE",
+		4: "REPORT_REASON_VIOLENCE",
+		5: "REPORT_REASON_NUDITY",
+		6: "REPORT_REASON_MISINFORMATION",
+		7: "REPORT_REASON_OTHER",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_UNSPECIFIED":    0,
+		"REPORT_REASON_SPAM":           1,
+		"REPORT_REASON_HARASSMENT":     2,
+		"REPORT_REASON_HATE":           3,
+		"REPORT_REASON_VIOLENCE":       4,
+		"REPORT_REASON_NUDITY":         5,
+		"REPORT_REASON_MISINFORMATION": 6,
+		"REPORT_REASON_OTHER":          7,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_proto_enumTypes[3].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_proto_post_proto_enumTypes[3]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{3}
+}
+
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_RESOLVED    ReportStatus = 2
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_RESOLVED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_RESOLVED":    2,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_proto_enumTypes[4].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_proto_post_proto_enumTypes[4]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{4}
+}
+
+// ModerationDecision is what a moderator does with a reported post
+type ModerationDecision int32
+
+const (
+	ModerationDecision_MODERATION_DECISION_UNSPECIFIED ModerationDecision = 0
+	// Leave the post as it is
+	ModerationDecision_MODERATION_DECISION_DISMISS ModerationDecision = 1
+	// Hide the post and its reposts from everyone
+	ModerationDecision_MODERATION_DECISION_HIDE_POST ModerationDecision = 2
+	// Delete the post like its author would
+	ModerationDecision_MODERATION_DECISION_REMOVE_POST ModerationDecision = 3
+)
+
+// Enum value maps for ModerationDecision.
+var (
+	ModerationDecision_name = map[int32]string{
+		0: "MODERATION_DECISION_UNSPECIFIED",
+		1: "MODERATION_DECISION_DISMISS",
+		2: "MODERATION_DECISION_HIDE_POST",
+		3: "MODERATION_DECISION_REMOVE_POST",
+	}
+	ModerationDecision_value = map[string]int32{
+		"MODERATION_DECISION_UNSPECIFIED": 0,
+		"MODERATION_DECISION_DISMISS":     1,
+		"MODERATION_DECISION_HIDE_POST":   2,
+		"MODERATION_DECISION_REMOVE_POST": 3,
+	}
+)
+
+func (x ModerationDecision) Enum() *ModerationDecision {
+	p := new(ModerationDecision)
+	*p = x
+	return p
+}
+
+func (x ModerationDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_proto_enumTypes[5].Descriptor()
+}
+
+func (ModerationDecision) Type() protoreflect.EnumType {
+	return &file_proto_post_proto_enumTypes[5]
+}
+
+func (x ModerationDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationDecision.Descriptor instead.
+func (ModerationDecision) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{5}
+}
+
+type ModerationActionType int32
+
+const (
+	ModerationActionType_MODERATION_ACTION_TYPE_UNSPECIFIED    ModerationActionType = 0
+	ModerationActionType_MODERATION_ACTION_TYPE_DISMISS        ModerationActionType = 1
+	ModerationActionType_MODERATION_ACTION_TYPE_HIDE_POST      ModerationActionType = 2
+	ModerationActionType_MODERATION_ACTION_TYPE_REMOVE_POST    ModerationActionType = 3
+	ModerationActionType_MODERATION_ACTION_TYPE_SUSPEND_AUTHOR ModerationActionType = 4
+)
+
+// Enum value maps for ModerationActionType.
+var (
+	ModerationActionType_name = map[int32]string{
+		0: "MODERATION_ACTION_TYPE_UNSPECIFIED",
+		1: "MODERATION_ACTION_TYPE_DISMISS",
+		2: "MODERATION_ACTION_TYPE_HIDE_POST",
+		3: "MODERATION_ACTION_TYPE_REMOVE_POST",
+		4: "MODERATION_ACTION_TYPE_SUSPEND_AUTHOR",
+	}
+	ModerationActionType_value = map[string]int32{
+		"MODERATION_ACTION_TYPE_UNSPECIFIED":    0,
+		"MODERATION_ACTION_TYPE_DISMISS":        1,
+		"MODERATION_ACTION_TYPE_HIDE_POST":      2,
+		"MODERATION_ACTION_TYPE_REMOVE_POST":    3,
+		"MODERATION_ACTION_TYPE_SUSPEND_AUTHOR": 4,
+	}
+)
+
+func (x ModerationActionType) Enum() *ModerationActionType {
+	p := new(ModerationActionType)
+	*p = x
+	return p
+}
+
+func (x ModerationActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_proto_enumTypes[6].Descriptor()
+}
+
+func (ModerationActionType) Type() protoreflect.EnumType {
+	return &file_proto_post_proto_enumTypes[6]
+}
+
+func (x ModerationActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationActionType.Descriptor instead.
+func (ModerationActionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{6}
+}
+
 type Post struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                  `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId      string                  `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	AuthorName    string                  `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	ImageUrl      *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Likes         int32                   `protobuf:"varint,6,opt,name=likes,proto3" json:"likes,omitempty"`
-	Timestamp     string                  `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	state        protoimpl.MessageState  `protogen:"open.v1"`
+	Id           string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content      string                  `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorId     string                  `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName   string                  `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	ImageUrl     *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Likes        int32                   `protobuf:"varint,6,opt,name=likes,proto3" json:"likes,omitempty"`
+	Timestamp    string                  `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CommentCount int32                   `protobuf:"varint,8,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	Entities     []*PostEntity           `protobuf:"bytes,9,rep,name=entities,proto3" json:"entities,omitempty"`
+	// Attachments in display order. image_url is the first one's URL.
+	Media []*PostMedia `protobuf:"bytes,10,rep,name=media,proto3" json:"media,omitempty"`
+	// Reaction counts in the configured order, zero counts omitted. likes
+	// equals the count of the "like" reaction.
+	Reactions []*ReactionCount `protobuf:"bytes,11,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// Reaction types the authenticated caller has added, empty for anonymous
+	// callers and on streamed events
+	ViewerReactions []string `protobuf:"bytes,12,rep,name=viewer_reactions,json=viewerReactions,proto3" json:"viewer_reactions,omitempty"`
+	// Set on reposts, which have no content of their own
+	RepostOfId string `protobuf:"bytes,13,opt,name=repost_of_id,json=repostOfId,proto3" json:"repost_of_id,omitempty"`
+	// The reposted post, unless it was deleted. Not set on streamed events.
+	RepostOf *Post `protobuf:"bytes,14,opt,name=repost_of,json=repostOf,proto3" json:"repost_of,omitempty"`
+	// Set on quote posts
+	QuotedPostId string `protobuf:"bytes,15,opt,name=quoted_post_id,json=quotedPostId,proto3" json:"quoted_post_id,omitempty"`
+	// The quoted post, unless it was deleted. Not set on streamed events.
+	QuotedPost  *Post `protobuf:"bytes,16,opt,name=quoted_post,json=quotedPost,proto3" json:"quoted_post,omitempty"`
+	RepostCount int32 `protobuf:"varint,17,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	// Set on replies: the post replied to and the first post of the thread
+	ParentId string `protobuf:"bytes,18,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	RootId   string `protobuf:"bytes,19,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	// Number of direct replies
+	ReplyCount int32 `protobuf:"varint,20,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	// Whether the authenticated caller bookmarked the post. Always false for
+	// anonymous callers and on streamed events.
+	ViewerHasBookmarked bool `protobuf:"varint,21,opt,name=viewer_has_bookmarked,json=viewerHasBookmarked,proto3" json:"viewer_has_bookmarked,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_proto_post_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Post) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Post) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Post) GetImageUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return nil
+}
+
+func (x *Post) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *Post) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Post) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Post) GetEntities() []*PostEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Post) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Post) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Post) GetViewerReactions() []string {
+	if x != nil {
+		return x.ViewerReactions
+	}
+	return nil
+}
+
+func (x *Post) GetRepostOfId() string {
+	if x != nil {
+		return x.RepostOfId
+	}
+	return ""
+}
+
+func (x *Post) GetRepostOf() *Post {
+	if x != nil {
+		return x.RepostOf
+	}
+	return nil
+}
+
+func (x *Post) GetQuotedPostId() string {
+	if x != nil {
+		return x.QuotedPostId
+	}
+	return ""
+}
+
+func (x *Post) GetQuotedPost() *Post {
+	if x != nil {
+		return x.QuotedPost
+	}
+	return nil
+}
+
+func (x *Post) GetRepostCount() int32 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+func (x *Post) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Post) GetRootId() string {
+	if x != nil {
+		return x.RootId
+	}
+	return ""
+}
+
+func (x *Post) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Post) GetViewerHasBookmarked() bool {
+	if x != nil {
+		return x.ViewerHasBookmarked
+	}
+	return false
+}
+
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_proto_post_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReactionCount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReactionCount) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ReactionType is one of the configured reactions
+type ReactionType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionType) Reset() {
+	*x = ReactionType{}
+	mi := &file_proto_post_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionType) ProtoMessage() {}
+
+func (x *ReactionType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionType.ProtoReflect.Descriptor instead.
+func (*ReactionType) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReactionType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReactionType) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type ListReactionTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionTypesRequest) Reset() {
+	*x = ListReactionTypesRequest{}
+	mi := &file_proto_post_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionTypesRequest) ProtoMessage() {}
+
+func (x *ListReactionTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListReactionTypesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{3}
+}
+
+type ListReactionTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []*ReactionType        `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionTypesResponse) Reset() {
+	*x = ListReactionTypesResponse{}
+	mi := &file_proto_post_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionTypesResponse) ProtoMessage() {}
+
+func (x *ListReactionTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListReactionTypesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListReactionTypesResponse) GetTypes() []*ReactionType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// ReactionRequest adds or removes the caller's reaction of the given type.
+// LikePost and UnlikePost are the same as using type "like".
+// GetThreadRequest reads the conversation around post_id: its ancestors
+// and depth levels of replies below it (3 by default, at most 10). Each
+// post lists its first replies oldest first; after continues the replies
+// to post_id itself.
+type GetThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	First         int32                  `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	mi := &file_proto_post_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetThreadRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetThreadRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type GetThreadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Posts replied to, from the root of the thread down to the parent
+	Ancestors     []*Post     `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	Thread        *ThreadNode `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
+	mi := &file_proto_post_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadResponse) ProtoMessage() {}
+
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetThreadResponse) GetAncestors() []*Post {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetThread() *ThreadNode {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+// ThreadNode is a post with a page of its replies. Posts at the depth limit
+// have no replies listed, and a thread lists at most 200 replies in all, so
+// later posts may list fewer. has_next_page tells whether there are more,
+// which GetThread on that post returns.
+type ThreadNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Replies       []*ThreadNode          `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"`
+	PageInfo      *PageInfo              `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadNode) Reset() {
+	*x = ThreadNode{}
+	mi := &file_proto_post_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadNode) ProtoMessage() {}
+
+func (x *ThreadNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadNode.ProtoReflect.Descriptor instead.
+func (*ThreadNode) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ThreadNode) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *ThreadNode) GetReplies() []*ThreadNode {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *ThreadNode) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+// RepostRequest reposts post_id, or undoes the caller's repost of it, as
+// the authenticated caller. A repost ID stands for the post it shares.
+type RepostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepostRequest) Reset() {
+	*x = RepostRequest{}
+	mi := &file_proto_post_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostRequest) ProtoMessage() {}
+
+func (x *RepostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostRequest.ProtoReflect.Descriptor instead.
+func (*RepostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RepostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type ReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionRequest) Reset() {
+	*x = ReactionRequest{}
+	mi := &file_proto_post_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionRequest) ProtoMessage() {}
+
+func (x *ReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionRequest.ProtoReflect.Descriptor instead.
+func (*ReactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReactionRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ReactionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// PostMedia is an attachment of a post. Width, height, thumbnail_url and
+// blurhash are unset for image URLs supplied by clients.
+type PostMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          PostMediaType          `protobuf:"varint,2,opt,name=type,proto3,enum=post.PostMediaType" json:"type,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Alt           string                 `protobuf:"bytes,7,opt,name=alt,proto3" json:"alt,omitempty"`
+	Blurhash      string                 `protobuf:"bytes,8,opt,name=blurhash,proto3" json:"blurhash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostMedia) Reset() {
+	*x = PostMedia{}
+	mi := &file_proto_post_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMedia) ProtoMessage() {}
+
+func (x *PostMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMedia.ProtoReflect.Descriptor instead.
+func (*PostMedia) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PostMedia) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostMedia) GetType() PostMediaType {
+	if x != nil {
+		return x.Type
+	}
+	return PostMediaType_POST_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *PostMedia) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PostMedia) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *PostMedia) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PostMedia) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PostMedia) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+func (x *PostMedia) GetBlurhash() string {
+	if x != nil {
+		return x.Blurhash
+	}
+	return ""
+}
+
+// PostEntity is a hashtag or mention in the content. start and end are
+// offsets in Unicode code points (Go runes), not bytes or UTF-16 code
+// units, end exclusive, and include the # or @.
+type PostEntity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  PostEntityType         `protobuf:"varint,1,opt,name=type,proto3,enum=post.PostEntityType" json:"type,omitempty"`
+	// Text after the # or @, as written
+	Text  string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Start int32  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	// Mentioned user, set for mentions only
+	UserId        string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEntity) Reset() {
+	*x = PostEntity{}
+	mi := &file_proto_post_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEntity) ProtoMessage() {}
+
+func (x *PostEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEntity.ProtoReflect.Descriptor instead.
+func (*PostEntity) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PostEntity) GetType() PostEntityType {
+	if x != nil {
+		return x.Type
+	}
+	return PostEntityType_POST_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *PostEntity) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PostEntity) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *PostEntity) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *PostEntity) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetPostsRequest pages either by limit/offset or, when first or after is
+// set, by opaque cursors over (created_at, id).
+type GetPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	First         int32                  `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsRequest) Reset() {
+	*x = GetPostsRequest{}
+	mi := &file_proto_post_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsRequest) ProtoMessage() {}
+
+func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPostsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPostsRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *GetPostsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// GetPostsResponse fills posts and total for offset paging, and edges and
+// page_info for cursor paging.
+type GetPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Edges         []*PostEdge            `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	PageInfo      *PageInfo              `protobuf:"bytes,4,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsResponse) Reset() {
+	*x = GetPostsResponse{}
+	mi := &file_proto_post_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsResponse) ProtoMessage() {}
+
+func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetPostsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPostsResponse) GetEdges() []*PostEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *GetPostsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type PostEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEdge) Reset() {
+	*x = PostEdge{}
+	mi := &file_proto_post_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEdge) ProtoMessage() {}
+
+func (x *PostEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEdge.ProtoReflect.Descriptor instead.
+func (*PostEdge) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PostEdge) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type PageInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartCursor     string                 `protobuf:"bytes,1,opt,name=start_cursor,json=startCursor,proto3" json:"start_cursor,omitempty"`
+	EndCursor       string                 `protobuf:"bytes,2,opt,name=end_cursor,json=endCursor,proto3" json:"end_cursor,omitempty"`
+	HasNextPage     bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	HasPreviousPage bool                   `protobuf:"varint,4,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	mi := &file_proto_post_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageInfo) ProtoMessage() {}
+
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PageInfo) GetStartCursor() string {
+	if x != nil {
+		return x.StartCursor
+	}
+	return ""
+}
+
+func (x *PageInfo) GetEndCursor() string {
+	if x != nil {
+		return x.EndCursor
+	}
+	return ""
+}
+
+func (x *PageInfo) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *PageInfo) GetHasPreviousPage() bool {
+	if x != nil {
+		return x.HasPreviousPage
+	}
+	return false
+}
+
+type CreatePostRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Content  string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorId string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// An http or https image URL, attached as is with an unknown size.
+	// Deprecated: upload images with UploadMedia and attach them with media.
+	//
+	// Deprecated: Marked as deprecated in proto/post.proto.
+	ImageUrl *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// Media uploaded by the caller with UploadMedia. Its URL becomes the
+	// post's image_url; image_url must then be unset.
+	MediaId string `protobuf:"bytes,4,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	// Uploads to attach in order, at most 4, each with optional alt text.
+	// Cannot be combined with image_url or media_id.
+	Media []*MediaAttachment `protobuf:"bytes,5,rep,name=media,proto3" json:"media,omitempty"`
+	// Post to quote. Quoting a repost quotes the post it shares.
+	QuotedPostId string `protobuf:"bytes,6,opt,name=quoted_post_id,json=quotedPostId,proto3" json:"quoted_post_id,omitempty"`
+	// Post to reply to. Replying to a repost replies to the post it shares.
+	ParentId      string `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_proto_post_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/post.proto.
+func (x *CreatePostRequest) GetImageUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetMedia() []*MediaAttachment {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetQuotedPostId() string {
+	if x != nil {
+		return x.QuotedPostId
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type MediaAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MediaId       string                 `protobuf:"bytes,1,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	Alt           string                 `protobuf:"bytes,2,opt,name=alt,proto3" json:"alt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaAttachment) Reset() {
+	*x = MediaAttachment{}
+	mi := &file_proto_post_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaAttachment) ProtoMessage() {}
+
+func (x *MediaAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaAttachment.ProtoReflect.Descriptor instead.
+func (*MediaAttachment) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MediaAttachment) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+func (x *MediaAttachment) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+// HomeFeedRequest pages the posts of the users the authenticated caller
+// follows, newest first, by opaque cursors over (created_at, id).
+type HomeFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         int32                  `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HomeFeedRequest) Reset() {
+	*x = HomeFeedRequest{}
+	mi := &file_proto_post_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HomeFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HomeFeedRequest) ProtoMessage() {}
+
+func (x *HomeFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HomeFeedRequest.ProtoReflect.Descriptor instead.
+func (*HomeFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HomeFeedRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *HomeFeedRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// BookmarkRequest saves post_id for the authenticated caller, or removes
+// it from their bookmarks. Bookmarks are private to their owner. A repost
+// ID stands for the post it shares.
+type BookmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkRequest) Reset() {
+	*x = BookmarkRequest{}
+	mi := &file_proto_post_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkRequest) ProtoMessage() {}
+
+func (x *BookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkRequest.ProtoReflect.Descriptor instead.
+func (*BookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BookmarkRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// ListBookmarksRequest pages the caller's bookmarked posts, most recently
+// bookmarked first, by opaque cursors over the bookmark's (created_at, id).
+type ListBookmarksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         int32                  `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
+	mi := &file_proto_post_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksRequest) ProtoMessage() {}
+
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListBookmarksRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *ListBookmarksRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// SearchPostsRequest runs a full-text search. Words must all match,
+// "quoted phrases" match in order and a trailing * matches prefixes.
+// Results are ranked best first and paged by opaque cursors.
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	First         int32                  `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_proto_post_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type SearchPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         []*SearchResultEdge    `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	PageInfo      *PageInfo              `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	mi := &file_proto_post_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResponse) ProtoMessage() {}
+
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchPostsResponse) GetEdges() []*SearchResultEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *SearchPostsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type SearchResultEdge struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Post   *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Cursor string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Excerpt of the content, HTML-escaped, with matches wrapped in <mark>
+	Snippet       string  `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Rank          float32 `protobuf:"fixed32,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResultEdge) Reset() {
+	*x = SearchResultEdge{}
+	mi := &file_proto_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResultEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResultEdge) ProtoMessage() {}
+
+func (x *SearchResultEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResultEdge.ProtoReflect.Descriptor instead.
+func (*SearchResultEdge) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchResultEdge) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *SearchResultEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchResultEdge) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResultEdge) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// PostsByHashtagRequest pages the posts tagged with tag, newest first. The
+// tag is matched case-insensitively, with or without the leading #.
+type PostsByHashtagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	First         int32                  `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostsByHashtagRequest) Reset() {
+	*x = PostsByHashtagRequest{}
+	mi := &file_proto_post_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostsByHashtagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostsByHashtagRequest) ProtoMessage() {}
+
+func (x *PostsByHashtagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostsByHashtagRequest.ProtoReflect.Descriptor instead.
+func (*PostsByHashtagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PostsByHashtagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PostsByHashtagRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *PostsByHashtagRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type GetPostByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostByIdRequest) Reset() {
+	*x = GetPostByIdRequest{}
+	mi := &file_proto_post_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostByIdRequest) ProtoMessage() {}
+
+func (x *GetPostByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetPostByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPostByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdatePostRequest changes only the fields that are set. An image_url
+// replaces all attachments, and an empty one removes them.
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ImageUrl      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_proto_post_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdatePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetImageUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return nil
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_proto_post_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeletePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_proto_post_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeletePostResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type LikePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikePostRequest) Reset() {
+	*x = LikePostRequest{}
+	mi := &file_proto_post_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostRequest) ProtoMessage() {}
+
+func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
+func (*LikePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LikePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *LikePostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnlikePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikePostRequest) Reset() {
+	*x = UnlikePostRequest{}
+	mi := &file_proto_post_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikePostRequest) ProtoMessage() {}
+
+func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
+func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnlikePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *UnlikePostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_proto_post_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type CreateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_proto_post_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// ListCommentsRequest pages a post's comments oldest first by opaque
+// cursors over (created_at, id).
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	First         int32                  `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_proto_post_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCommentsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         []*CommentEdge         `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	PageInfo      *PageInfo              `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_proto_post_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListCommentsResponse) GetEdges() []*CommentEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+// ListCommentsByPostsRequest fetches the first page of comments of up to
+// 100 posts at once.
+type ListCommentsByPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostIds       []string               `protobuf:"bytes,1,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	First         int32                  `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsByPostsRequest) Reset() {
+	*x = ListCommentsByPostsRequest{}
+	mi := &file_proto_post_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsByPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsByPostsRequest) ProtoMessage() {}
+
+func (x *ListCommentsByPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsByPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsByPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListCommentsByPostsRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+func (x *ListCommentsByPostsRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+// ListCommentsByPostsResponse holds a page per post ID. Posts that do not
+// exist or are hidden have no page.
+type ListCommentsByPostsResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Pages         map[string]*ListCommentsResponse `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsByPostsResponse) Reset() {
+	*x = ListCommentsByPostsResponse{}
+	mi := &file_proto_post_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsByPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsByPostsResponse) ProtoMessage() {}
+
+func (x *ListCommentsByPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsByPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsByPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCommentsByPostsResponse) GetPages() map[string]*ListCommentsResponse {
+	if x != nil {
+		return x.Pages
+	}
+	return nil
+}
+
+type CommentEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentEdge) Reset() {
+	*x = CommentEdge{}
+	mi := &file_proto_post_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentEdge) ProtoMessage() {}
+
+func (x *CommentEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentEdge.ProtoReflect.Descriptor instead.
+func (*CommentEdge) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CommentEdge) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CommentEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_proto_post_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_proto_post_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteCommentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StreamPostsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// When set, only events for posts written by these authors are sent
+	AuthorIds []string `protobuf:"bytes,2,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	// When set, only events for these posts are sent
+	PostIds       []string `protobuf:"bytes,3,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPostsRequest) Reset() {
+	*x = StreamPostsRequest{}
+	mi := &file_proto_post_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPostsRequest) ProtoMessage() {}
+
+func (x *StreamPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPostsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StreamPostsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamPostsRequest) GetAuthorIds() []string {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+func (x *StreamPostsRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type PostEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  PostEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=post.PostEventType" json:"type,omitempty"`
+	Post  *Post                  `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	// User who triggered the event (the author, the (un)liker or reactor,
+	// the commenter, the reposter or the deleting user)
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Reaction type of REACTED and UNREACTED events
+	Reaction      string `protobuf:"bytes,4,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEvent) Reset() {
+	*x = PostEvent{}
+	mi := &file_proto_post_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEvent) ProtoMessage() {}
+
+func (x *PostEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEvent.ProtoReflect.Descriptor instead.
+func (*PostEvent) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PostEvent) GetType() PostEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PostEventType_POST_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PostEvent) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PostEvent) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+// UploadMediaRequest is streamed by UploadMedia clients: metadata first,
+// then the file in chunks.
+type UploadMediaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadMediaRequest_Metadata
+	//	*UploadMediaRequest_Chunk
+	Data          isUploadMediaRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaRequest) Reset() {
+	*x = UploadMediaRequest{}
+	mi := &file_proto_post_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaRequest) ProtoMessage() {}
+
+func (x *UploadMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaRequest.ProtoReflect.Descriptor instead.
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UploadMediaRequest) GetData() isUploadMediaRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadMediaRequest) GetMetadata() *MediaMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadMediaRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadMediaRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadMediaRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadMediaRequest_Data interface {
+	isUploadMediaRequest_Data()
+}
+
+type UploadMediaRequest_Metadata struct {
+	Metadata *MediaMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadMediaRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadMediaRequest_Metadata) isUploadMediaRequest_Data() {}
+
+func (*UploadMediaRequest_Chunk) isUploadMediaRequest_Data() {}
+
+type MediaMetadata struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Filename string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Informational; the type is detected from the file contents
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Expected size in bytes, if known, so oversized uploads fail early
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaMetadata) Reset() {
+	*x = MediaMetadata{}
+	mi := &file_proto_post_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaMetadata) ProtoMessage() {}
+
+func (x *MediaMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaMetadata.ProtoReflect.Descriptor instead.
+func (*MediaMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MediaMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MediaMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MediaMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// Media is a processed upload. Metadata such as EXIF is removed and a
+// thumbnail no larger than 320 pixels on each side is generated.
+type Media struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url          string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl string                 `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ContentType  string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width        int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height       int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Size         int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// Compact placeholder shown while the image loads, see blurha.sh
+	Blurhash      string `protobuf:"bytes,8,opt,name=blurhash,proto3" json:"blurhash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_proto_post_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Media) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Media) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Media) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Media) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Media) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Media) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Media) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Media) GetBlurhash() string {
+	if x != nil {
+		return x.Blurhash
+	}
+	return ""
+}
+
+// ReportPostRequest reports post_id to moderators as the authenticated
+// caller. A repost ID stands for the post it shares.
+type ReportPostRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reason ReportReason           `protobuf:"varint,2,opt,name=reason,proto3,enum=post.ReportReason" json:"reason,omitempty"`
+	// Optional context from the reporter, at most 1000 characters
+	Details       string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPostRequest) Reset() {
+	*x = ReportPostRequest{}
+	mi := &file_proto_post_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostRequest) ProtoMessage() {}
+
+func (x *ReportPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostRequest.ProtoReflect.Descriptor instead.
+func (*ReportPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReportPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ReportPostRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportPostRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type Report struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// The reported post as moderators see it, including hidden and removed
+	// posts. Unset for reporters.
+	Post       *Post        `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
+	ReporterId string       `protobuf:"bytes,4,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason     ReportReason `protobuf:"varint,5,opt,name=reason,proto3,enum=post.ReportReason" json:"reason,omitempty"`
+	Details    string       `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	Status     ReportStatus `protobuf:"varint,7,opt,name=status,proto3,enum=post.ReportStatus" json:"status,omitempty"`
+	// Set once resolved
+	Decision      ModerationDecision `protobuf:"varint,8,opt,name=decision,proto3,enum=post.ModerationDecision" json:"decision,omitempty"`
+	ResolvedBy    string             `protobuf:"bytes,9,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolvedAt    string             `protobuf:"bytes,10,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	CreatedAt     string             `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_proto_post_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Report) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Report) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Report) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *Report) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *Report) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *Report) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *Report) GetDecision() ModerationDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return ModerationDecision_MODERATION_DECISION_UNSPECIFIED
+}
+
+func (x *Report) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *Report) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ListReportsRequest pages the reports with the given status, open by
+// default, oldest first.
+type ListReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ReportStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=post.ReportStatus" json:"status,omitempty"`
+	First         int32                  `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
-	mi := &file_proto_post_proto_msgTypes[0]
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_proto_post_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *ListReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*ListReportsRequest) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_proto_msgTypes[0]
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,83 +3508,107 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_proto_post_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Post) GetId() string {
+func (x *ListReportsRequest) GetStatus() ReportStatus {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return ""
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
 }
 
-func (x *Post) GetContent() string {
+func (x *ListReportsRequest) GetFirst() int32 {
 	if x != nil {
-		return x.Content
+		return x.First
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetAuthorId() string {
+func (x *ListReportsRequest) GetAfter() string {
 	if x != nil {
-		return x.AuthorId
+		return x.After
 	}
 	return ""
 }
 
-func (x *Post) GetAuthorName() string {
-	if x != nil {
-		return x.AuthorName
-	}
-	return ""
+type ListReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         []*ReportEdge          `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	PageInfo      *PageInfo              `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) GetImageUrl() *wrapperspb.StringValue {
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_proto_post_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[48]
 	if x != nil {
-		return x.ImageUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetLikes() int32 {
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListReportsResponse) GetEdges() []*ReportEdge {
 	if x != nil {
-		return x.Likes
+		return x.Edges
 	}
-	return 0
+	return nil
 }
 
-func (x *Post) GetTimestamp() string {
+func (x *ListReportsResponse) GetPageInfo() *PageInfo {
 	if x != nil {
-		return x.Timestamp
+		return x.PageInfo
 	}
-	return ""
+	return nil
 }
 
-type GetPostsRequest struct {
+type ReportEdge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsRequest) Reset() {
-	*x = GetPostsRequest{}
-	mi := &file_proto_post_proto_msgTypes[1]
+func (x *ReportEdge) Reset() {
+	*x = ReportEdge{}
+	mi := &file_proto_post_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsRequest) String() string {
+func (x *ReportEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsRequest) ProtoMessage() {}
+func (*ReportEdge) ProtoMessage() {}
 
-func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_proto_msgTypes[1]
+func (x *ReportEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,48 +3619,55 @@ func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetPostsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ReportEdge.ProtoReflect.Descriptor instead.
+func (*ReportEdge) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetPostsRequest) GetLimit() int32 {
+func (x *ReportEdge) GetReport() *Report {
 	if x != nil {
-		return x.Limit
+		return x.Report
 	}
-	return 0
+	return nil
 }
 
-func (x *GetPostsRequest) GetOffset() int32 {
+func (x *ReportEdge) GetCursor() string {
 	if x != nil {
-		return x.Offset
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-type GetPostsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+// ResolveReportRequest applies a decision to an open report's post. Hiding
+// or removing the post resolves every open report of it.
+type ResolveReportRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReportId string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Decision ModerationDecision     `protobuf:"varint,2,opt,name=decision,proto3,enum=post.ModerationDecision" json:"decision,omitempty"`
+	// Also keep the post's author from posting, editing, commenting and
+	// reposting
+	SuspendAuthor bool `protobuf:"varint,3,opt,name=suspend_author,json=suspendAuthor,proto3" json:"suspend_author,omitempty"`
+	// Recorded in the audit trail
+	Note          string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsResponse) Reset() {
-	*x = GetPostsResponse{}
-	mi := &file_proto_post_proto_msgTypes[2]
+func (x *ResolveReportRequest) Reset() {
+	*x = ResolveReportRequest{}
+	mi := &file_proto_post_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsResponse) String() string {
+func (x *ResolveReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsResponse) ProtoMessage() {}
+func (*ResolveReportRequest) ProtoMessage() {}
 
-func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_proto_msgTypes[2]
+func (x *ResolveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,49 +3678,70 @@ func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_post_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ResolveReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetPostsResponse) GetPosts() []*Post {
+func (x *ResolveReportRequest) GetReportId() string {
 	if x != nil {
-		return x.Posts
+		return x.ReportId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetPostsResponse) GetTotal() int32 {
+func (x *ResolveReportRequest) GetDecision() ModerationDecision {
 	if x != nil {
-		return x.Total
+		return x.Decision
 	}
-	return 0
+	return ModerationDecision_MODERATION_DECISION_UNSPECIFIED
 }
 
-type CreatePostRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Content       string                  `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId      string                  `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	ImageUrl      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+func (x *ResolveReportRequest) GetSuspendAuthor() bool {
+	if x != nil {
+		return x.SuspendAuthor
+	}
+	return false
+}
+
+func (x *ResolveReportRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// ModerationAction is an entry in the audit trail of moderator decisions
+type ModerationAction struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModeratorId string                 `protobuf:"bytes,2,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Type        ModerationActionType   `protobuf:"varint,3,opt,name=type,proto3,enum=post.ModerationActionType" json:"type,omitempty"`
+	ReportId    string                 `protobuf:"bytes,4,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	PostId      string                 `protobuf:"bytes,5,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// The suspended user, for suspensions
+	UserId        string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	mi := &file_proto_post_proto_msgTypes[3]
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	mi := &file_proto_post_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *ModerationAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*ModerationAction) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_proto_msgTypes[3]
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,54 +3752,91 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CreatePostRequest) GetContent() string {
+func (x *ModerationAction) GetId() string {
 	if x != nil {
-		return x.Content
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreatePostRequest) GetAuthorId() string {
+func (x *ModerationAction) GetModeratorId() string {
 	if x != nil {
-		return x.AuthorId
+		return x.ModeratorId
 	}
 	return ""
 }
 
-func (x *CreatePostRequest) GetImageUrl() *wrapperspb.StringValue {
+func (x *ModerationAction) GetType() ModerationActionType {
 	if x != nil {
-		return x.ImageUrl
+		return x.Type
 	}
-	return nil
+	return ModerationActionType_MODERATION_ACTION_TYPE_UNSPECIFIED
 }
 
-type GetPostByIdRequest struct {
+func (x *ModerationAction) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ListModerationActionsRequest pages the audit trail, newest first
+type ListModerationActionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	First         int32                  `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostByIdRequest) Reset() {
-	*x = GetPostByIdRequest{}
-	mi := &file_proto_post_proto_msgTypes[4]
+func (x *ListModerationActionsRequest) Reset() {
+	*x = ListModerationActionsRequest{}
+	mi := &file_proto_post_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostByIdRequest) String() string {
+func (x *ListModerationActionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIdRequest) ProtoMessage() {}
+func (*ListModerationActionsRequest) ProtoMessage() {}
 
-func (x *GetPostByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_proto_msgTypes[4]
+func (x *ListModerationActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,41 +3847,48 @@ func (x *GetPostByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetPostByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListModerationActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetPostByIdRequest) GetId() string {
+func (x *ListModerationActionsRequest) GetFirst() int32 {
 	if x != nil {
-		return x.Id
+		return x.First
+	}
+	return 0
+}
+
+func (x *ListModerationActionsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
 	}
 	return ""
 }
 
-type LikePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type ListModerationActionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Edges         []*ModerationActionEdge `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	PageInfo      *PageInfo               `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikePostRequest) Reset() {
-	*x = LikePostRequest{}
-	mi := &file_proto_post_proto_msgTypes[5]
+func (x *ListModerationActionsResponse) Reset() {
+	*x = ListModerationActionsResponse{}
+	mi := &file_proto_post_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostRequest) String() string {
+func (x *ListModerationActionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostRequest) ProtoMessage() {}
+func (*ListModerationActionsResponse) ProtoMessage() {}
 
-func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_proto_msgTypes[5]
+func (x *ListModerationActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,47 +3899,48 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
-func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListModerationActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *LikePostRequest) GetPostId() string {
+func (x *ListModerationActionsResponse) GetEdges() []*ModerationActionEdge {
 	if x != nil {
-		return x.PostId
+		return x.Edges
 	}
-	return ""
+	return nil
 }
 
-func (x *LikePostRequest) GetUserId() string {
+func (x *ListModerationActionsResponse) GetPageInfo() *PageInfo {
 	if x != nil {
-		return x.UserId
+		return x.PageInfo
 	}
-	return ""
+	return nil
 }
 
-type StreamPostsRequest struct {
+type ModerationActionEdge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action        *ModerationAction      `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamPostsRequest) Reset() {
-	*x = StreamPostsRequest{}
-	mi := &file_proto_post_proto_msgTypes[6]
+func (x *ModerationActionEdge) Reset() {
+	*x = ModerationActionEdge{}
+	mi := &file_proto_post_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamPostsRequest) String() string {
+func (x *ModerationActionEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPostsRequest) ProtoMessage() {}
+func (*ModerationActionEdge) ProtoMessage() {}
 
-func (x *StreamPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_proto_msgTypes[6]
+func (x *ModerationActionEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,14 +3951,21 @@ func (x *StreamPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPostsRequest.ProtoReflect.Descriptor instead.
-func (*StreamPostsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ModerationActionEdge.ProtoReflect.Descriptor instead.
+func (*ModerationActionEdge) Descriptor() ([]byte, []int) {
+	return file_proto_post_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *StreamPostsRequest) GetUserId() string {
+func (x *ModerationActionEdge) GetAction() *ModerationAction {
 	if x != nil {
-		return x.UserId
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ModerationActionEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
@@ -422,7 +3974,7 @@ var File_proto_post_proto protoreflect.FileDescriptor
 
 const file_proto_post_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/post.proto\x12\x04post\x1a\x1egoogle/protobuf/wrappers.proto\"\xdd\x01\n" +
+	"\x10proto/post.proto\x12\x04post\x1a\x1egoogle/protobuf/wrappers.proto\"\x81\x06\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
@@ -431,25 +3983,308 @@ const file_proto_post_proto_rawDesc = "" +
 	"authorName\x129\n" +
 	"\timage_url\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\bimageUrl\x12\x14\n" +
 	"\x05likes\x18\x06 \x01(\x05R\x05likes\x12\x1c\n" +
-	"\ttimestamp\x18\a \x01(\tR\ttimestamp\"?\n" +
+	"\ttimestamp\x18\a \x01(\tR\ttimestamp\x12#\n" +
+	"\rcomment_count\x18\b \x01(\x05R\fcommentCount\x12,\n" +
+	"\bentities\x18\t \x03(\v2\x10.post.PostEntityR\bentities\x12%\n" +
+	"\x05media\x18\n" +
+	" \x03(\v2\x0f.post.PostMediaR\x05media\x121\n" +
+	"\treactions\x18\v \x03(\v2\x13.post.ReactionCountR\treactions\x12)\n" +
+	"\x10viewer_reactions\x18\f \x03(\tR\x0fviewerReactions\x12 \n" +
+	"\frepost_of_id\x18\r \x01(\tR\n" +
+	"repostOfId\x12'\n" +
+	"\trepost_of\x18\x0e \x01(\v2\n" +
+	".post.PostR\brepostOf\x12$\n" +
+	"\x0equoted_post_id\x18\x0f \x01(\tR\fquotedPostId\x12+\n" +
+	"\vquoted_post\x18\x10 \x01(\v2\n" +
+	".post.PostR\n" +
+	"quotedPost\x12!\n" +
+	"\frepost_count\x18\x11 \x01(\x05R\vrepostCount\x12\x1b\n" +
+	"\tparent_id\x18\x12 \x01(\tR\bparentId\x12\x17\n" +
+	"\aroot_id\x18\x13 \x01(\tR\x06rootId\x12\x1f\n" +
+	"\vreply_count\x18\x14 \x01(\x05R\n" +
+	"replyCount\x122\n" +
+	"\x15viewer_has_bookmarked\x18\x15 \x01(\bR\x13viewerHasBookmarked\"O\n" +
+	"\rReactionCount\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"8\n" +
+	"\fReactionType\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\"\x1a\n" +
+	"\x18ListReactionTypesRequest\"E\n" +
+	"\x19ListReactionTypesResponse\x12(\n" +
+	"\x05types\x18\x01 \x03(\v2\x12.post.ReactionTypeR\x05types\"m\n" +
+	"\x10GetThreadRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\x12\x14\n" +
+	"\x05first\x18\x03 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\tR\x05after\"g\n" +
+	"\x11GetThreadResponse\x12(\n" +
+	"\tancestors\x18\x01 \x03(\v2\n" +
+	".post.PostR\tancestors\x12(\n" +
+	"\x06thread\x18\x02 \x01(\v2\x10.post.ThreadNodeR\x06thread\"\x85\x01\n" +
+	"\n" +
+	"ThreadNode\x12\x1e\n" +
+	"\x04post\x18\x01 \x01(\v2\n" +
+	".post.PostR\x04post\x12*\n" +
+	"\areplies\x18\x02 \x03(\v2\x10.post.ThreadNodeR\areplies\x12+\n" +
+	"\tpage_info\x18\x03 \x01(\v2\x0e.post.PageInfoR\bpageInfo\"(\n" +
+	"\rRepostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\">\n" +
+	"\x0fReactionRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"\xd7\x01\n" +
+	"\tPostMedia\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x13.post.PostMediaTypeR\x04type\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\x04 \x01(\tR\fthumbnailUrl\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03alt\x18\a \x01(\tR\x03alt\x12\x1a\n" +
+	"\bblurhash\x18\b \x01(\tR\bblurhash\"\x8b\x01\n" +
+	"\n" +
+	"PostEntity\x12(\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x14.post.PostEntityTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\"k\n" +
 	"\x0fGetPostsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"J\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05first\x18\x03 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\tR\x05after\"\x9d\x01\n" +
 	"\x10GetPostsResponse\x12 \n" +
 	"\x05posts\x18\x01 \x03(\v2\n" +
 	".post.PostR\x05posts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\x85\x01\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12$\n" +
+	"\x05edges\x18\x03 \x03(\v2\x0e.post.PostEdgeR\x05edges\x12+\n" +
+	"\tpage_info\x18\x04 \x01(\v2\x0e.post.PageInfoR\bpageInfo\"B\n" +
+	"\bPostEdge\x12\x1e\n" +
+	"\x04post\x18\x01 \x01(\v2\n" +
+	".post.PostR\x04post\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\"\x9c\x01\n" +
+	"\bPageInfo\x12!\n" +
+	"\fstart_cursor\x18\x01 \x01(\tR\vstartCursor\x12\x1d\n" +
+	"\n" +
+	"end_cursor\x18\x02 \x01(\tR\tendCursor\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12*\n" +
+	"\x11has_previous_page\x18\x04 \x01(\bR\x0fhasPreviousPage\"\x94\x02\n" +
 	"\x11CreatePostRequest\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1b\n" +
-	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x129\n" +
-	"\timage_url\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\bimageUrl\"$\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12=\n" +
+	"\timage_url\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueB\x02\x18\x01R\bimageUrl\x12\x19\n" +
+	"\bmedia_id\x18\x04 \x01(\tR\amediaId\x12+\n" +
+	"\x05media\x18\x05 \x03(\v2\x15.post.MediaAttachmentR\x05media\x12$\n" +
+	"\x0equoted_post_id\x18\x06 \x01(\tR\fquotedPostId\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\tR\bparentId\">\n" +
+	"\x0fMediaAttachment\x12\x19\n" +
+	"\bmedia_id\x18\x01 \x01(\tR\amediaId\x12\x10\n" +
+	"\x03alt\x18\x02 \x01(\tR\x03alt\"=\n" +
+	"\x0fHomeFeedRequest\x12\x14\n" +
+	"\x05first\x18\x01 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x02 \x01(\tR\x05after\"*\n" +
+	"\x0fBookmarkRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\"B\n" +
+	"\x14ListBookmarksRequest\x12\x14\n" +
+	"\x05first\x18\x01 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x02 \x01(\tR\x05after\"V\n" +
+	"\x12SearchPostsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"p\n" +
+	"\x13SearchPostsResponse\x12,\n" +
+	"\x05edges\x18\x01 \x03(\v2\x16.post.SearchResultEdgeR\x05edges\x12+\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x0e.post.PageInfoR\bpageInfo\"x\n" +
+	"\x10SearchResultEdge\x12\x1e\n" +
+	"\x04post\x18\x01 \x01(\v2\n" +
+	".post.PostR\x04post\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\x02R\x04rank\"U\n" +
+	"\x15PostsByHashtagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"$\n" +
 	"\x12GetPostByIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x96\x01\n" +
+	"\x11UpdatePostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\acontent\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\acontent\x129\n" +
+	"\timage_url\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\bimageUrl\"#\n" +
+	"\x11DeletePostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12DeletePostResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
 	"\x0fLikePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"-\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"E\n" +
+	"\x11UnlikePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xa8\x01\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp\"I\n" +
+	"\x14CreateCommentRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"Z\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"l\n" +
+	"\x14ListCommentsResponse\x12'\n" +
+	"\x05edges\x18\x01 \x03(\v2\x11.post.CommentEdgeR\x05edges\x12+\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x0e.post.PageInfoR\bpageInfo\"M\n" +
+	"\x1aListCommentsByPostsRequest\x12\x19\n" +
+	"\bpost_ids\x18\x01 \x03(\tR\apostIds\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\x05R\x05first\"\xb7\x01\n" +
+	"\x1bListCommentsByPostsResponse\x12B\n" +
+	"\x05pages\x18\x01 \x03(\v2,.post.ListCommentsByPostsResponse.PagesEntryR\x05pages\x1aT\n" +
+	"\n" +
+	"PagesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.post.ListCommentsResponseR\x05value:\x028\x01\"N\n" +
+	"\vCommentEdge\x12'\n" +
+	"\acomment\x18\x01 \x01(\v2\r.post.CommentR\acomment\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15DeleteCommentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"g\n" +
 	"\x12StreamPostsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId2\x96\x02\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"author_ids\x18\x02 \x03(\tR\tauthorIds\x12\x19\n" +
+	"\bpost_ids\x18\x03 \x03(\tR\apostIds\"\x89\x01\n" +
+	"\tPostEvent\x12'\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x13.post.PostEventTypeR\x04type\x12\x1e\n" +
+	"\x04post\x18\x02 \x01(\v2\n" +
+	".post.PostR\x04post\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\breaction\x18\x04 \x01(\tR\breaction\"g\n" +
+	"\x12UploadMediaRequest\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.post.MediaMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"b\n" +
+	"\rMediaMetadata\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"\xcf\x01\n" +
+	"\x05Media\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\x03 \x01(\tR\fthumbnailUrl\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x1a\n" +
+	"\bblurhash\x18\b \x01(\tR\bblurhash\"r\n" +
+	"\x11ReportPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12*\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x12.post.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\x03 \x01(\tR\adetails\"\xfb\x02\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1e\n" +
+	"\x04post\x18\x03 \x01(\v2\n" +
+	".post.PostR\x04post\x12\x1f\n" +
+	"\vreporter_id\x18\x04 \x01(\tR\n" +
+	"reporterId\x12*\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x12.post.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\x06 \x01(\tR\adetails\x12*\n" +
+	"\x06status\x18\a \x01(\x0e2\x12.post.ReportStatusR\x06status\x124\n" +
+	"\bdecision\x18\b \x01(\x0e2\x18.post.ModerationDecisionR\bdecision\x12\x1f\n" +
+	"\vresolved_by\x18\t \x01(\tR\n" +
+	"resolvedBy\x12\x1f\n" +
+	"\vresolved_at\x18\n" +
+	" \x01(\tR\n" +
+	"resolvedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"l\n" +
+	"\x12ListReportsRequest\x12*\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x12.post.ReportStatusR\x06status\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"j\n" +
+	"\x13ListReportsResponse\x12&\n" +
+	"\x05edges\x18\x01 \x03(\v2\x10.post.ReportEdgeR\x05edges\x12+\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x0e.post.PageInfoR\bpageInfo\"J\n" +
+	"\n" +
+	"ReportEdge\x12$\n" +
+	"\x06report\x18\x01 \x01(\v2\f.post.ReportR\x06report\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\"\xa4\x01\n" +
+	"\x14ResolveReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x124\n" +
+	"\bdecision\x18\x02 \x01(\x0e2\x18.post.ModerationDecisionR\bdecision\x12%\n" +
+	"\x0esuspend_author\x18\x03 \x01(\bR\rsuspendAuthor\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xf7\x01\n" +
+	"\x10ModerationAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fmoderator_id\x18\x02 \x01(\tR\vmoderatorId\x12.\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.post.ModerationActionTypeR\x04type\x12\x1b\n" +
+	"\treport_id\x18\x04 \x01(\tR\breportId\x12\x17\n" +
+	"\apost_id\x18\x05 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"J\n" +
+	"\x1cListModerationActionsRequest\x12\x14\n" +
+	"\x05first\x18\x01 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x02 \x01(\tR\x05after\"~\n" +
+	"\x1dListModerationActionsResponse\x120\n" +
+	"\x05edges\x18\x01 \x03(\v2\x1a.post.ModerationActionEdgeR\x05edges\x12+\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x0e.post.PageInfoR\bpageInfo\"^\n" +
+	"\x14ModerationActionEdge\x12.\n" +
+	"\x06action\x18\x01 \x01(\v2\x16.post.ModerationActionR\x06action\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor*d\n" +
+	"\rPostMediaType\x12\x1f\n" +
+	"\x1bPOST_MEDIA_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15POST_MEDIA_TYPE_IMAGE\x10\x01\x12\x17\n" +
+	"\x13POST_MEDIA_TYPE_GIF\x10\x02*n\n" +
+	"\x0ePostEntityType\x12 \n" +
+	"\x1cPOST_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18POST_ENTITY_TYPE_HASHTAG\x10\x01\x12\x1c\n" +
+	"\x18POST_ENTITY_TYPE_MENTION\x10\x02*\xb8\x02\n" +
+	"\rPostEventType\x12\x1f\n" +
+	"\x1bPOST_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_CREATED\x10\x01\x12\x19\n" +
+	"\x15POST_EVENT_TYPE_LIKED\x10\x02\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_UPDATED\x10\x03\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_DELETED\x10\x04\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_UNLIKED\x10\x05\x12\x1d\n" +
+	"\x19POST_EVENT_TYPE_COMMENTED\x10\x06\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_REACTED\x10\a\x12\x1d\n" +
+	"\x19POST_EVENT_TYPE_UNREACTED\x10\b\x12\x1c\n" +
+	"\x18POST_EVENT_TYPE_REPOSTED\x10\t*\xec\x01\n" +
+	"\fReportReason\x12\x1d\n" +
+	"\x19REPORT_REASON_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_REASON_SPAM\x10\x01\x12\x1c\n" +
+	"\x18REPORT_REASON_HARASSMENT\x10\x02\x12\x16\n" +
+	"\x12REPORT_REASON_HATE\x10\x03\x12\x1a\n" +
+	"\x16REPORT_REASON_VIOLENCE\x10\x04\x12\x18\n" +
+	"\x14REPORT_REASON_NUDITY\x10\x05\x12 \n" +
+	"\x1cREPORT_REASON_MISINFORMATION\x10\x06\x12\x17\n" +
+	"\x13REPORT_REASON_OTHER\x10\a*a\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1a\n" +
+	"\x16REPORT_STATUS_RESOLVED\x10\x02*\xa2\x01\n" +
+	"\x12ModerationDecision\x12#\n" +
+	"\x1fMODERATION_DECISION_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bMODERATION_DECISION_DISMISS\x10\x01\x12!\n" +
+	"\x1dMODERATION_DECISION_HIDE_POST\x10\x02\x12#\n" +
+	"\x1fMODERATION_DECISION_REMOVE_POST\x10\x03*\xdb\x01\n" +
+	"\x14ModerationActionType\x12&\n" +
+	"\"MODERATION_ACTION_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eMODERATION_ACTION_TYPE_DISMISS\x10\x01\x12$\n" +
+	" MODERATION_ACTION_TYPE_HIDE_POST\x10\x02\x12&\n" +
+	"\"MODERATION_ACTION_TYPE_REMOVE_POST\x10\x03\x12)\n" +
+	"%MODERATION_ACTION_TYPE_SUSPEND_AUTHOR\x10\x042\xf9\r\n" +
 	"\vPostService\x129\n" +
 	"\bGetPosts\x12\x15.post.GetPostsRequest\x1a\x16.post.GetPostsResponse\x121\n" +
 	"\n" +
@@ -458,9 +4293,46 @@ const file_proto_post_proto_rawDesc = "" +
 	"\vGetPostById\x12\x18.post.GetPostByIdRequest\x1a\n" +
 	".post.Post\x12-\n" +
 	"\bLikePost\x12\x15.post.LikePostRequest\x1a\n" +
-	".post.Post\x125\n" +
-	"\vStreamPosts\x12\x18.post.StreamPostsRequest\x1a\n" +
-	".post.Post0\x01B\fZ\n" +
+	".post.Post\x121\n" +
+	"\n" +
+	"UnlikePost\x12\x17.post.UnlikePostRequest\x1a\n" +
+	".post.Post\x12:\n" +
+	"\vStreamPosts\x12\x18.post.StreamPostsRequest\x1a\x0f.post.PostEvent0\x01\x121\n" +
+	"\n" +
+	"UpdatePost\x12\x17.post.UpdatePostRequest\x1a\n" +
+	".post.Post\x12?\n" +
+	"\n" +
+	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x18.post.DeletePostResponse\x12:\n" +
+	"\rCreateComment\x12\x1a.post.CreateCommentRequest\x1a\r.post.Comment\x12E\n" +
+	"\fListComments\x12\x19.post.ListCommentsRequest\x1a\x1a.post.ListCommentsResponse\x12Z\n" +
+	"\x13ListCommentsByPosts\x12 .post.ListCommentsByPostsRequest\x1a!.post.ListCommentsByPostsResponse\x12H\n" +
+	"\rDeleteComment\x12\x1a.post.DeleteCommentRequest\x1a\x1b.post.DeleteCommentResponse\x129\n" +
+	"\bHomeFeed\x12\x15.post.HomeFeedRequest\x1a\x16.post.GetPostsResponse\x12B\n" +
+	"\vSearchPosts\x12\x18.post.SearchPostsRequest\x1a\x19.post.SearchPostsResponse\x12E\n" +
+	"\x0ePostsByHashtag\x12\x1b.post.PostsByHashtagRequest\x1a\x16.post.GetPostsResponse\x126\n" +
+	"\vUploadMedia\x12\x18.post.UploadMediaRequest\x1a\v.post.Media(\x01\x120\n" +
+	"\vAddReaction\x12\x15.post.ReactionRequest\x1a\n" +
+	".post.Post\x123\n" +
+	"\x0eRemoveReaction\x12\x15.post.ReactionRequest\x1a\n" +
+	".post.Post\x12T\n" +
+	"\x11ListReactionTypes\x12\x1e.post.ListReactionTypesRequest\x1a\x1f.post.ListReactionTypesResponse\x12)\n" +
+	"\x06Repost\x12\x13.post.RepostRequest\x1a\n" +
+	".post.Post\x12-\n" +
+	"\n" +
+	"UndoRepost\x12\x13.post.RepostRequest\x1a\n" +
+	".post.Post\x12<\n" +
+	"\tGetThread\x12\x16.post.GetThreadRequest\x1a\x17.post.GetThreadResponse\x12-\n" +
+	"\bBookmark\x12\x15.post.BookmarkRequest\x1a\n" +
+	".post.Post\x12/\n" +
+	"\n" +
+	"Unbookmark\x12\x15.post.BookmarkRequest\x1a\n" +
+	".post.Post\x12C\n" +
+	"\rListBookmarks\x12\x1a.post.ListBookmarksRequest\x1a\x16.post.GetPostsResponse\x123\n" +
+	"\n" +
+	"ReportPost\x12\x17.post.ReportPostRequest\x1a\f.post.Report\x12B\n" +
+	"\vListReports\x12\x18.post.ListReportsRequest\x1a\x19.post.ListReportsResponse\x129\n" +
+	"\rResolveReport\x12\x1a.post.ResolveReportRequest\x1a\f.post.Report\x12`\n" +
+	"\x15ListModerationActions\x12\".post.ListModerationActionsRequest\x1a#.post.ListModerationActionsResponseB\fZ\n" +
 	"muze/protob\x06proto3"
 
 var (
@@ -475,36 +4347,185 @@ func file_proto_post_proto_rawDescGZIP() []byte {
 	return file_proto_post_proto_rawDescData
 }
 
-var file_proto_post_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_post_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_post_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_proto_post_proto_goTypes = []any{
-	(*Post)(nil),                   // 0: post.Post
-	(*GetPostsRequest)(nil),        // 1: post.GetPostsRequest
-	(*GetPostsResponse)(nil),       // 2: post.GetPostsResponse
-	(*CreatePostRequest)(nil),      // 3: post.CreatePostRequest
-	(*GetPostByIdRequest)(nil),     // 4: post.GetPostByIdRequest
-	(*LikePostRequest)(nil),        // 5: post.LikePostRequest
-	(*StreamPostsRequest)(nil),     // 6: post.StreamPostsRequest
-	(*wrapperspb.StringValue)(nil), // 7: google.protobuf.StringValue
+	(PostMediaType)(0),                    // 0: post.PostMediaType
+	(PostEntityType)(0),                   // 1: post.PostEntityType
+	(PostEventType)(0),                    // 2: post.PostEventType
+	(ReportReason)(0),                     // 3: post.ReportReason
+	(ReportStatus)(0),                     // 4: post.ReportStatus
+	(ModerationDecision)(0),               // 5: post.ModerationDecision
+	(ModerationActionType)(0),             // 6: post.ModerationActionType
+	(*Post)(nil),                          // 7: post.Post
+	(*ReactionCount)(nil),                 // 8: post.ReactionCount
+	(*ReactionType)(nil),                  // 9: post.ReactionType
+	(*ListReactionTypesRequest)(nil),      // 10: post.ListReactionTypesRequest
+	(*ListReactionTypesResponse)(nil),     // 11: post.ListReactionTypesResponse
+	(*GetThreadRequest)(nil),              // 12: post.GetThreadRequest
+	(*GetThreadResponse)(nil),             // 13: post.GetThreadResponse
+	(*ThreadNode)(nil),                    // 14: post.ThreadNode
+	(*RepostRequest)(nil),                 // 15: post.RepostRequest
+	(*ReactionRequest)(nil),               // 16: post.ReactionRequest
+	(*PostMedia)(nil),                     // 17: post.PostMedia
+	(*PostEntity)(nil),                    // 18: post.PostEntity
+	(*GetPostsRequest)(nil),               // 19: post.GetPostsRequest
+	(*GetPostsResponse)(nil),              // 20: post.GetPostsResponse
+	(*PostEdge)(nil),                      // 21: post.PostEdge
+	(*PageInfo)(nil),                      // 22: post.PageInfo
+	(*CreatePostRequest)(nil),             // 23: post.CreatePostRequest
+	(*MediaAttachment)(nil),               // 24: post.MediaAttachment
+	(*HomeFeedRequest)(nil),               // 25: post.HomeFeedRequest
+	(*BookmarkRequest)(nil),               // 26: post.BookmarkRequest
+	(*ListBookmarksRequest)(nil),          // 27: post.ListBookmarksRequest
+	(*SearchPostsRequest)(nil),            // 28: post.SearchPostsRequest
+	(*SearchPostsResponse)(nil),           // 29: post.SearchPostsResponse
+	(*SearchResultEdge)(nil),              // 30: post.SearchResultEdge
+	(*PostsByHashtagRequest)(nil),         // 31: post.PostsByHashtagRequest
+	(*GetPostByIdRequest)(nil),            // 32: post.GetPostByIdRequest
+	(*UpdatePostRequest)(nil),             // 33: post.UpdatePostRequest
+	(*DeletePostRequest)(nil),             // 34: post.DeletePostRequest
+	(*DeletePostResponse)(nil),            // 35: post.DeletePostResponse
+	(*LikePostRequest)(nil),               // 36: post.LikePostRequest
+	(*UnlikePostRequest)(nil),             // 37: post.UnlikePostRequest
+	(*Comment)(nil),                       // 38: post.Comment
+	(*CreateCommentRequest)(nil),          // 39: post.CreateCommentRequest
+	(*ListCommentsRequest)(nil),           // 40: post.ListCommentsRequest
+	(*ListCommentsResponse)(nil),          // 41: post.ListCommentsResponse
+	(*ListCommentsByPostsRequest)(nil),    // 42: post.ListCommentsByPostsRequest
+	(*ListCommentsByPostsResponse)(nil),   // 43: post.ListCommentsByPostsResponse
+	(*CommentEdge)(nil),                   // 44: post.CommentEdge
+	(*DeleteCommentRequest)(nil),          // 45: post.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),         // 46: post.DeleteCommentResponse
+	(*StreamPostsRequest)(nil),            // 47: post.StreamPostsRequest
+	(*PostEvent)(nil),                     // 48: post.PostEvent
+	(*UploadMediaRequest)(nil),            // 49: post.UploadMediaRequest
+	(*MediaMetadata)(nil),                 // 50: post.MediaMetadata
+	(*Media)(nil),                         // 51: post.Media
+	(*ReportPostRequest)(nil),             // 52: post.ReportPostRequest
+	(*Report)(nil),                        // 53: post.Report
+	(*ListReportsRequest)(nil),            // 54: post.ListReportsRequest
+	(*ListReportsResponse)(nil),           // 55: post.ListReportsResponse
+	(*ReportEdge)(nil),                    // 56: post.ReportEdge
+	(*ResolveReportRequest)(nil),          // 57: post.ResolveReportRequest
+	(*ModerationAction)(nil),              // 58: post.ModerationAction
+	(*ListModerationActionsRequest)(nil),  // 59: post.ListModerationActionsRequest
+	(*ListModerationActionsResponse)(nil), // 60: post.ListModerationActionsResponse
+	(*ModerationActionEdge)(nil),          // 61: post.ModerationActionEdge
+	nil,                                   // 62: post.ListCommentsByPostsResponse.PagesEntry
+	(*wrapperspb.StringValue)(nil),        // 63: google.protobuf.StringValue
 }
 var file_proto_post_proto_depIdxs = []int32{
-	7, // 0: post.Post.image_url:type_name -> google.protobuf.StringValue
-	0, // 1: post.GetPostsResponse.posts:type_name -> post.Post
-	7, // 2: post.CreatePostRequest.image_url:type_name -> google.protobuf.StringValue
-	1, // 3: post.PostService.GetPosts:input_type -> post.GetPostsRequest
-	3, // 4: post.PostService.CreatePost:input_type -> post.CreatePostRequest
-	4, // 5: post.PostService.GetPostById:input_type -> post.GetPostByIdRequest
-	5, // 6: post.PostService.LikePost:input_type -> post.LikePostRequest
-	6, // 7: post.PostService.StreamPosts:input_type -> post.StreamPostsRequest
-	2, // 8: post.PostService.GetPosts:output_type -> post.GetPostsResponse
-	0, // 9: post.PostService.CreatePost:output_type -> post.Post
-	0, // 10: post.PostService.GetPostById:output_type -> post.Post
-	0, // 11: post.PostService.LikePost:output_type -> post.Post
-	0, // 12: post.PostService.StreamPosts:output_type -> post.Post
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	63, // 0: post.Post.image_url:type_name -> google.protobuf.StringValue
+	18, // 1: post.Post.entities:type_name -> post.PostEntity
+	17, // 2: post.Post.media:type_name -> post.PostMedia
+	8,  // 3: post.Post.reactions:type_name -> post.ReactionCount
+	7,  // 4: post.Post.repost_of:type_name -> post.Post
+	7,  // 5: post.Post.quoted_post:type_name -> post.Post
+	9,  // 6: post.ListReactionTypesResponse.types:type_name -> post.ReactionType
+	7,  // 7: post.GetThreadResponse.ancestors:type_name -> post.Post
+	14, // 8: post.GetThreadResponse.thread:type_name -> post.ThreadNode
+	7,  // 9: post.ThreadNode.post:type_name -> post.Post
+	14, // 10: post.ThreadNode.replies:type_name -> post.ThreadNode
+	22, // 11: post.ThreadNode.page_info:type_name -> post.PageInfo
+	0,  // 12: post.PostMedia.type:type_name -> post.PostMediaType
+	1,  // 13: post.PostEntity.type:type_name -> post.PostEntityType
+	7,  // 14: post.GetPostsResponse.posts:type_name -> post.Post
+	21, // 15: post.GetPostsResponse.edges:type_name -> post.PostEdge
+	22, // 16: post.GetPostsResponse.page_info:type_name -> post.PageInfo
+	7,  // 17: post.PostEdge.post:type_name -> post.Post
+	63, // 18: post.CreatePostRequest.image_url:type_name -> google.protobuf.StringValue
+	24, // 19: post.CreatePostRequest.media:type_name -> post.MediaAttachment
+	30, // 20: post.SearchPostsResponse.edges:type_name -> post.SearchResultEdge
+	22, // 21: post.SearchPostsResponse.page_info:type_name -> post.PageInfo
+	7,  // 22: post.SearchResultEdge.post:type_name -> post.Post
+	63, // 23: post.UpdatePostRequest.content:type_name -> google.protobuf.StringValue
+	63, // 24: post.UpdatePostRequest.image_url:type_name -> google.protobuf.StringValue
+	44, // 25: post.ListCommentsResponse.edges:type_name -> post.CommentEdge
+	22, // 26: post.ListCommentsResponse.page_info:type_name -> post.PageInfo
+	62, // 27: post.ListCommentsByPostsResponse.pages:type_name -> post.ListCommentsByPostsResponse.PagesEntry
+	38, // 28: post.CommentEdge.comment:type_name -> post.Comment
+	2,  // 29: post.PostEvent.type:type_name -> post.PostEventType
+	7,  // 30: post.PostEvent.post:type_name -> post.Post
+	50, // 31: post.UploadMediaRequest.metadata:type_name -> post.MediaMetadata
+	3,  // 32: post.ReportPostRequest.reason:type_name -> post.ReportReason
+	7,  // 33: post.Report.post:type_name -> post.Post
+	3,  // 34: post.Report.reason:type_name -> post.ReportReason
+	4,  // 35: post.Report.status:type_name -> post.ReportStatus
+	5,  // 36: post.Report.decision:type_name -> post.ModerationDecision
+	4,  // 37: post.ListReportsRequest.status:type_name -> post.ReportStatus
+	56, // 38: post.ListReportsResponse.edges:type_name -> post.ReportEdge
+	22, // 39: post.ListReportsResponse.page_info:type_name -> post.PageInfo
+	53, // 40: post.ReportEdge.report:type_name -> post.Report
+	5,  // 41: post.ResolveReportRequest.decision:type_name -> post.ModerationDecision
+	6,  // 42: post.ModerationAction.type:type_name -> post.ModerationActionType
+	61, // 43: post.ListModerationActionsResponse.edges:type_name -> post.ModerationActionEdge
+	22, // 44: post.ListModerationActionsResponse.page_info:type_name -> post.PageInfo
+	58, // 45: post.ModerationActionEdge.action:type_name -> post.ModerationAction
+	41, // 46: post.ListCommentsByPostsResponse.PagesEntry.value:type_name -> post.ListCommentsResponse
+	19, // 47: post.PostService.GetPosts:input_type -> post.GetPostsRequest
+	23, // 48: post.PostService.CreatePost:input_type -> post.CreatePostRequest
+	32, // 49: post.PostService.GetPostById:input_type -> post.GetPostByIdRequest
+	36, // 50: post.PostService.LikePost:input_type -> post.LikePostRequest
+	37, // 51: post.PostService.UnlikePost:input_type -> post.UnlikePostRequest
+	47, // 52: post.PostService.StreamPosts:input_type -> post.StreamPostsRequest
+	33, // 53: post.PostService.UpdatePost:input_type -> post.UpdatePostRequest
+	34, // 54: post.PostService.DeletePost:input_type -> post.DeletePostRequest
+	39, // 55: post.PostService.CreateComment:input_type -> post.CreateCommentRequest
+	40, // 56: post.PostService.ListComments:input_type -> post.ListCommentsRequest
+	42, // 57: post.PostService.ListCommentsByPosts:input_type -> post.ListCommentsByPostsRequest
+	45, // 58: post.PostService.DeleteComment:input_type -> post.DeleteCommentRequest
+	25, // 59: post.PostService.HomeFeed:input_type -> post.HomeFeedRequest
+	28, // 60: post.PostService.SearchPosts:input_type -> post.SearchPostsRequest
+	31, // 61: post.PostService.PostsByHashtag:input_type -> post.PostsByHashtagRequest
+	49, // 62: post.PostService.UploadMedia:input_type -> post.UploadMediaRequest
+	16, // 63: post.PostService.AddReaction:input_type -> post.ReactionRequest
+	16, // 64: post.PostService.RemoveReaction:input_type -> post.ReactionRequest
+	10, // 65: post.PostService.ListReactionTypes:input_type -> post.ListReactionTypesRequest
+	15, // 66: post.PostService.Repost:input_type -> post.RepostRequest
+	15, // 67: post.PostService.UndoRepost:input_type -> post.RepostRequest
+	12, // 68: post.PostService.GetThread:input_type -> post.GetThreadRequest
+	26, // 69: post.PostService.Bookmark:input_type -> post.BookmarkRequest
+	26, // 70: post.PostService.Unbookmark:input_type -> post.BookmarkRequest
+	27, // 71: post.PostService.ListBookmarks:input_type -> post.ListBookmarksRequest
+	52, // 72: post.PostService.ReportPost:input_type -> post.ReportPostRequest
+	54, // 73: post.PostService.ListReports:input_type -> post.ListReportsRequest
+	57, // 74: post.PostService.ResolveReport:input_type -> post.ResolveReportRequest
+	59, // 75: post.PostService.ListModerationActions:input_type -> post.ListModerationActionsRequest
+	20, // 76: post.PostService.GetPosts:output_type -> post.GetPostsResponse
+	7,  // 77: post.PostService.CreatePost:output_type -> post.Post
+	7,  // 78: post.PostService.GetPostById:output_type -> post.Post
+	7,  // 79: post.PostService.LikePost:output_type -> post.Post
+	7,  // 80: post.PostService.UnlikePost:output_type -> post.Post
+	48, // 81: post.PostService.StreamPosts:output_type -> post.PostEvent
+	7,  // 82: post.PostService.UpdatePost:output_type -> post.Post
+	35, // 83: post.PostService.DeletePost:output_type -> post.DeletePostResponse
+	38, // 84: post.PostService.CreateComment:output_type -> post.Comment
+	41, // 85: post.PostService.ListComments:output_type -> post.ListCommentsResponse
+	43, // 86: post.PostService.ListCommentsByPosts:output_type -> post.ListCommentsByPostsResponse
+	46, // 87: post.PostService.DeleteComment:output_type -> post.DeleteCommentResponse
+	20, // 88: post.PostService.HomeFeed:output_type -> post.GetPostsResponse
+	29, // 89: post.PostService.SearchPosts:output_type -> post.SearchPostsResponse
+	20, // 90: post.PostService.PostsByHashtag:output_type -> post.GetPostsResponse
+	51, // 91: post.PostService.UploadMedia:output_type -> post.Media
+	7,  // 92: post.PostService.AddReaction:output_type -> post.Post
+	7,  // 93: post.PostService.RemoveReaction:output_type -> post.Post
+	11, // 94: post.PostService.ListReactionTypes:output_type -> post.ListReactionTypesResponse
+	7,  // 95: post.PostService.Repost:output_type -> post.Post
+	7,  // 96: post.PostService.UndoRepost:output_type -> post.Post
+	13, // 97: post.PostService.GetThread:output_type -> post.GetThreadResponse
+	7,  // 98: post.PostService.Bookmark:output_type -> post.Post
+	7,  // 99: post.PostService.Unbookmark:output_type -> post.Post
+	20, // 100: post.PostService.ListBookmarks:output_type -> post.GetPostsResponse
+	53, // 101: post.PostService.ReportPost:output_type -> post.Report
+	55, // 102: post.PostService.ListReports:output_type -> post.ListReportsResponse
+	53, // 103: post.PostService.ResolveReport:output_type -> post.Report
+	60, // 104: post.PostService.ListModerationActions:output_type -> post.ListModerationActionsResponse
+	76, // [76:105] is the sub-list for method output_type
+	47, // [47:76] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_proto_post_proto_init() }
@@ -512,18 +4533,23 @@ func file_proto_post_proto_init() {
 	if File_proto_post_proto != nil {
 		return
 	}
+	file_proto_post_proto_msgTypes[42].OneofWrappers = []any{
+		(*UploadMediaRequest_Metadata)(nil),
+		(*UploadMediaRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_post_proto_rawDesc), len(file_proto_post_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      7,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_post_proto_goTypes,
 		DependencyIndexes: file_proto_post_proto_depIdxs,
+		EnumInfos:         file_proto_post_proto_enumTypes,
 		MessageInfos:      file_proto_post_proto_msgTypes,
 	}.Build()
 	File_proto_post_proto = out.File